@@ -4,28 +4,27 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"net/http"
+	"io"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"runtime"
-	"time"
 
 	"github.com/signalsciences/waf-testing-framework/pkg/app"
 	"github.com/signalsciences/waf-testing-framework/pkg/config"
 	"github.com/signalsciences/waf-testing-framework/pkg/logs"
-	"github.com/signalsciences/waf-testing-framework/pkg/results"
 	"github.com/sirupsen/logrus"
 )
 
 //version will be overwritten by release process flag
 var waftfversion = "0000.00.0"
 
+//the flags of the command line
+var configFile, mode, events string
+var debugMode, version, resume bool
+var workerLimit, maxProcs, ratelimit int
+
 func main() {
-	//the config file flag
-	var configFile string
-	var debugMode, version bool
-	var workerLimit, maxProcs, ratelimit int
 	flag.StringVar(&configFile, "config", "./config.yml", "path to the yaml config file")
 	flag.StringVar(&configFile, "c", "./config.yml", "path to the yaml config file (shorthand)")
 	flag.IntVar(&workerLimit, "worker", 10, "set the maximum number of requests to be sent concurrently")
@@ -36,8 +35,11 @@ func main() {
 	flag.BoolVar(&debugMode, "d", false, "sets the log level to debug (shorthand)")
 	flag.BoolVar(&version, "version", false, "prints the version of WAF testing tool")
 	flag.BoolVar(&version, "v", false, "prints the version of WAF testing tool (shorthand)")
-	flag.IntVar(&ratelimit, "rate", 50, "set the maximum transatcions per second WTT will generate")
-	flag.IntVar(&ratelimit, "r", 50, "set the maximum transatcions per second WTT will generate (shorthand)")
+	flag.IntVar(&ratelimit, "rate", 50, "set the maximum transatcions per second WTT will generate against each WAF")
+	flag.IntVar(&ratelimit, "r", 50, "set the maximum transatcions per second WTT will generate against each WAF (shorthand)")
+	flag.StringVar(&mode, "mode", "payload", "set the test mode: payload to test the detection of payloads, or rate to run the rate tests of the WAFs")
+	flag.StringVar(&events, "events", "", "stream the events of the run as NDJSON to a file, or to stdout with -, which moves the progress messages to stderr")
+	flag.BoolVar(&resume, "resume", false, "resume an interrupted run from the checkpoint in the output directory")
 	flag.Parse()
 
 	// print the version and exit
@@ -45,12 +47,17 @@ func main() {
 		fmt.Printf("WAF Testing Framework version %v\n", waftfversion)
 		os.Exit(0)
 	}
+	//the progress messages go to stderr when the events are streamed to stdout, so stdout only
+	//holds the events
+	var out io.Writer = os.Stdout
+	if events == "-" {
+		out = os.Stderr
+	}
 	//set environment parameters
 	runtime.GOMAXPROCS(maxProcs)
 	if ratelimit <= 0 {
 		ratelimit = 50
 	}
-	rate := time.Second / time.Duration(ratelimit)
 	//init new log
 	log := logs.NewLogger(filepath.FromSlash("output/runtime.log"))
 	if debugMode {
@@ -61,65 +68,72 @@ func main() {
 	log.Printf("starting WAF Testing Framework version %v\n", waftfversion)
 	log.Printf("using %v CPUs and %v workers", maxProcs, workerLimit)
 
+	//the deferred cleanup of the run, such as closing the events file, is done before exiting on an error
+	if err := run(out, log, errorLog); err != nil {
+		fmt.Fprintln(out, err)
+		log.Fatal(err)
+	}
+}
+
+//run runs the tests configured by the flags and generates the reports
+func run(out io.Writer, log *logrus.Logger, errorLog *logrus.Logger) error {
 	//read in the provided file
 	r, err := os.Open(configFile)
 	if err != nil {
-		fmt.Printf("unable to read yaml file: %v", err)
-		log.Fatalf("unable to read yaml file: %v", err)
+		return fmt.Errorf("unable to read yaml file: %v", err)
 	}
 	defer r.Close()
 
 	//load test configs
 	yamlTests, err := config.ParseYamlFile(r)
 	if err != nil {
-		fmt.Printf("unable to test configs: %v", err)
-		log.Fatalf("unable to test configs: %v", err)
+		return fmt.Errorf("unable to test configs: %v", err)
 	}
 
 	//parse the configs
 	testRun, err := config.ParseConfigs(yamlTests)
 	if err != nil {
-		fmt.Printf("unable to parse configs: %v", err)
-		log.Fatalf("unable to parse configs: %v", err)
+		return fmt.Errorf("unable to parse configs: %v", err)
 	}
-
-	//channel to put tests on
-	testsChan := make(chan *app.TestRequest, 50)
-	//channel to put results on
-	resultsChan := make(chan *results.TestResult, 50)
-	//channel to indicate queuing is done
-	doneQueuingChan := make(chan struct{}, 1)
-	//channel to indicate processing is done
-	doneProcessingChan := make(chan struct{}, 1)
-	//the stop channel stops the workers.
-	stopChan := make(chan struct{})
-	//the rate limit throttle channel
-	rateLimiter := time.NewTicker(rate)
-	//initialize application object
-	a := &app.Application{
-		Client: &http.Client{
-			Timeout: time.Second * 10,
-		},
-		TestRun:            testRun,
-		TestsChan:          testsChan,
-		ResultsChan:        resultsChan,
-		StopChan:           stopChan,
-		Log:                log,
-		ErrorLog:           errorLog,
-		Results:            results.InitResults(testRun),
-		DoneQueuingChan:    doneQueuingChan,
-		DoneProcessingChan: doneProcessingChan,
-		RateLimiter:        rateLimiter,
-		WorkerLimit:        workerLimit,
-	}
-	//ensure we can reach the targeted locations
-	a.ValidateURI()
-	//create a listener in a goroutine which will notify
-	//the done channel when it receives an interrupt from the OS.
+	options := []app.Option{
+		app.WithMode(mode),
+		app.WithWorkers(workerLimit),
+		app.WithRate(ratelimit),
+		app.WithCheckpoint(),
+		app.WithLogger(log, errorLog),
+		app.WithOutput(out),
+	}
+	//merge the results of the interrupted run and skip the tests it completed
+	if resume {
+		options = append(options, app.WithResume())
+	}
+	//stream the events of the run as they happen
+	if events == "-" {
+		options = append(options, app.WithHooks(app.NewNDJSONHook(os.Stdout)))
+	} else if events != "" {
+		hook, err := app.NewNDJSONFileHook(events)
+		if err != nil {
+			return fmt.Errorf("unable to open events file: %v", err)
+		}
+		defer func() {
+			if err := hook.Close(); err != nil {
+				fmt.Fprintf(out, "unable to write events: %v\n", err)
+				log.Errorf("unable to write events: %v", err)
+			}
+		}()
+		options = append(options, app.WithHooks(hook))
+	}
+	runner, err := app.New(testRun, options...)
+	if err != nil {
+		return fmt.Errorf("unable to start the tests: %v", err)
+	}
+	//create a listener in a goroutine which will cancel
+	//the run when it receives an interrupt from the OS.
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt)
+	doneChan := make(chan struct{})
 	//cleanup function to stop receiving signals and call cancel()
 	defer func() {
 		signal.Stop(interrupt)
@@ -128,28 +142,39 @@ func main() {
 	go func() {
 		select {
 		case <-interrupt:
-			fmt.Println("\r- Interrupt signal detected - shutting down workers")
-			a.Log.Infoln("interrupt signal detected - shutting down workers")
-			close(a.StopChan)
-			a.RequestWG.Wait()
-			a.ResultWG.Wait()
-			fmt.Println(("all workers shut down"))
-			a.Log.Infoln("all workers shut down")
-			os.Exit(0)
-		case <-ctx.Done():
-			fmt.Println("finished")
-			a.Log.Infoln("finished")
+			fmt.Fprintln(out, "\r- Interrupt signal detected - finishing the requests in flight and writing a partial report")
+			log.Infoln("interrupt signal detected - finishing the requests in flight and writing a partial report")
+			cancel()
+			//a second interrupt exits without waiting for the report
+			select {
+			case <-interrupt:
+				fmt.Fprintln(out, "\r- Second interrupt signal detected - exiting without a report")
+				log.Infoln("second interrupt signal detected - exiting without a report")
+				os.Exit(1)
+			case <-doneChan:
+			}
+		case <-doneChan:
+			fmt.Fprintln(out, "finished")
+			log.Infoln("finished")
 		}
 	}()
 	//run the app
-	a.Run()
-	fmt.Println("Generating report....")
-	//calculate counters and process data for the report
-	a.Results.ProcessResults()
-	a.Results.ReportData()
-	//generate reports
-	if err := a.Results.GenerateReports(); err != nil {
-		fmt.Printf("Unable to generate report: %v", err)
-		log.Fatalf("Unable to generate report: %v", err)
+	res, err := runner.Run(ctx)
+	close(doneChan)
+	if res == nil {
+		return fmt.Errorf("unable to run the tests: %v", err)
+	}
+	fmt.Fprintln(out, "Generating report....")
+	//generate reports, partial ones when the run was interrupted or failed
+	if err := res.GenerateReports(); err != nil {
+		return fmt.Errorf("unable to generate report: %v", err)
+	}
+	//the progress of interrupted payload runs is saved to the checkpoint
+	if res.Incomplete && mode == app.ModePayload {
+		fmt.Fprintln(out, "run again with -resume to send the remaining tests")
+	}
+	if err != nil && err != context.Canceled {
+		return fmt.Errorf("unable to finish the tests: %v", err)
 	}
+	return nil
 }
@@ -3,8 +3,11 @@ module github.com/signalsciences/waf-testing-framework
 go 1.14
 
 require (
-	github.com/google/go-cmp v0.4.1
+	github.com/andybalholm/brotli v1.0.0
+	github.com/google/go-cmp v0.5.0
 	github.com/schollz/progressbar/v3 v3.3.3
 	github.com/sirupsen/logrus v1.6.0
+	golang.org/x/net v0.0.0-20200707034311-ab3426394381
+	google.golang.org/protobuf v1.25.0
 	gopkg.in/yaml.v2 v2.2.8
 )
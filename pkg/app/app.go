@@ -3,10 +3,12 @@ package app
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"io"
-	"net"
+	"io/ioutil"
+	"math"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -30,8 +32,6 @@ const (
 	stringPass string = "pass"
 )
 
-var resultMapMutext = sync.RWMutex{}
-
 //HTTPClient wraps the http.Client to allow for setting timeouts
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
@@ -49,10 +49,21 @@ type Application struct {
 	Results            *results.Results
 	DoneQueuingChan    chan struct{}
 	DoneProcessingChan chan struct{}
+	Targets            map[string]*Target
 	RateLimiter        *time.Ticker
 	WorkerLimit        int
 	RequestWG          sync.WaitGroup
 	ResultWG           sync.WaitGroup
+	//Checkpoint saves the progress of the run to the output directory so it can be resumed
+	Checkpoint bool
+	//Hooks receive the events of the run as they happen
+	Hooks []Hook
+	//Out receives the progress messages of the run, which are discarded when nil
+	Out io.Writer
+	//completed holds the tests completed by the run and the run it resumed
+	completed map[testKey]bool
+	//resultMutex guards the results and the completed tests
+	resultMutex sync.RWMutex
 }
 
 //TestRequest represents a single test to be run against the app
@@ -65,35 +76,103 @@ type TestRequest struct {
 	TestType     string
 	Outcome      string
 	Location     string
+	TestLocation *config.TestLocation
 	AllowCon     *config.Condition
 	BlockCon     *config.Condition
 	Request      *http.Request
+	RawRequest   []byte
+	Desync       string
+	CloseCode    int
+	Message      string
 	Response     *http.Response
 	Error        error
+	//PaddingOffset is the offset of the payload parameter in a padded body
+	PaddingOffset int
+}
+
+//locationType returns the type of location the payload is placed in, which may differ
+//from the label the location is reported under
+func (t *TestRequest) locationType() string {
+	if t.TestLocation != nil && t.TestLocation.Template != nil {
+		return t.TestLocation.Inject
+	}
+	if t.TestLocation != nil {
+		return strings.ToLower(t.TestLocation.Location)
+	}
+	return t.Location
+}
+
+//dump returns the request as it was sent for reporting
+func (t *TestRequest) dump() ([]byte, error) {
+	if t.RawRequest != nil {
+		return t.RawRequest, nil
+	}
+	return httputil.DumpRequestOut(t.Request, true)
+}
+
+//send transacts the test request with the target of its test set. Requests needing framing
+//that net/http does not allow are serialized and written to the connection directly.
+func (a *Application) send(testRequest *TestRequest) (*http.Response, error) {
+	target := a.target(testRequest.SetName)
+	location := testRequest.TestLocation
+	if location != nil && location.Scenario != nil {
+		return a.sendScenario(testRequest)
+	}
+	if location != nil && strings.ToLower(location.Location) == "smuggling" {
+		return a.sendSmuggling(testRequest)
+	}
+	if location != nil && strings.ToLower(location.Location) == "websocket" {
+		return target.sendWebSocket(testRequest)
+	}
+	if loc := testRequest.locationType(); loc == "grpc" || loc == "grpc_web" {
+		return target.sendGRPC(testRequest)
+	}
+	if location != nil && strings.HasPrefix(strings.ToLower(location.Location), "h2_") {
+		return target.sendH2(testRequest)
+	}
+	if (location != nil && location.TransferEncoding == "chunked") || target.proto() == "HTTP/1.0" {
+		raw, err := encodeRaw(testRequest.Request, location, target.proto())
+		if err != nil {
+			return nil, err
+		}
+		testRequest.RawRequest = raw
+		return target.sendRaw(testRequest.Request, raw)
+	}
+	return target.Client.Do(testRequest.Request)
 }
 
 //ValidateURI loops through all the configured test URIs to ensure they are of valid format and reachable
-func (a *Application) ValidateURI() {
+func (a *Application) ValidateURI() error {
 	for _, testSet := range a.TestRun.TestSets {
 		u, err := url.ParseRequestURI(testSet.URI)
 		if err != nil {
-			fmt.Printf("Exiting because invalid URI %s. See log for details\n", testSet.URI)
-			a.Log.Fatalf("URI %s invalid, error: %v\n", testSet.URI, err)
+			return fmt.Errorf("URI %s invalid, error: %v", testSet.URI, err)
 		}
 		timeout := 1 * time.Second
-		_, err = net.DialTimeout("tcp", u.Hostname()+":"+u.Port(), timeout)
+		//the connection is dialed as test requests are, at the resolve override and through the proxy of the set
+		target := &Target{Set: testSet}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		conn, err := target.dialContext(ctx, "tcp", u.Host)
+		cancel()
 		if err != nil {
-			fmt.Printf("Exiting because URI %s unreachable. See log for details\n", u.Hostname()+":"+u.Port())
-			a.Log.Fatalf("URI %s unreachable, error: %v\n", u.Hostname()+":"+u.Port(), err)
+			addr := target.resolve(u.Host)
+			if proxy, parseErr := url.Parse(testSet.Proxy); testSet.Proxy != "" && parseErr == nil {
+				addr += " through proxy " + proxy.Host
+			}
+			return fmt.Errorf("URI %s unreachable at %s, error: %v", testSet.URI, addr, err)
 		}
+		conn.Close()
 	}
+	return nil
 }
 
 //Run spawns request workers and result workers up to the worker limit defined in the test configurations,
-//enqueues testRequest objects for processing, and exits when all workers are finished.
-func (a *Application) Run() {
-	fmt.Println("begin processing...")
+//enqueues testRequest objects for processing, and exits when all workers are finished. The tests
+//already queued are still sent when queuing fails.
+func (a *Application) Run() error {
+	fmt.Fprintln(a.out(), "begin processing...")
 	a.Log.Infoln("begin processing...")
+	a.runStart()
 
 	//use waitgroups to know when all work has been completed
 	//spawn workers
@@ -106,8 +185,11 @@ func (a *Application) Run() {
 		go a.resultWorker(r, a.StopChan)
 	}
 
+	//save the progress of the run as the results come in
+	stopCheckpoints := a.startCheckpoints()
+
 	//add testRequests to the channel
-	a.queueTests()
+	queueErr := a.queueTests()
 
 	//wait for all request processing to finish
 	a.RequestWG.Wait()
@@ -115,16 +197,85 @@ func (a *Application) Run() {
 	//send the signal that no new requests will be queued by closing
 	//the a.DoneProcessingChan channel
 	close(a.DoneProcessingChan)
-	fmt.Println("finished sending requests")
+	fmt.Fprintln(a.out(), "finished sending requests")
 	a.Log.Infoln("finished sending requests")
 	close(a.TestsChan)
+	//tests left queued when the run was interrupted were never sent
+	for testRequest := range a.TestsChan {
+		a.countUnsent(testRequest.SetName)
+	}
 
 	//wait for all result processing to finish
 	a.ResultWG.Wait()
-	fmt.Println("finished processing results")
+	fmt.Fprintln(a.out(), "finished processing results")
 	a.Log.Infoln("finished processing results")
 	close(a.ResultsChan)
-	a.RateLimiter.Stop()
+	stopCheckpoints()
+	if a.RateLimiter != nil {
+		a.RateLimiter.Stop()
+	}
+	a.recordRates()
+	if a.stopped() {
+		a.Results.Incomplete = true
+		fmt.Fprintln(a.out(), "the run was interrupted, the report only holds the tests sent")
+		a.Log.Infoln("the run was interrupted, the report only holds the tests sent")
+	}
+	if queueErr != nil {
+		a.Results.Incomplete = true
+	}
+	a.finishCheckpoint()
+	//calculate counters and process data for the report
+	a.Results.ProcessResults()
+	a.runEnd()
+	return queueErr
+}
+
+//stopped returns true once the run has been interrupted
+func (a *Application) stopped() bool {
+	select {
+	case <-a.StopChan:
+		return true
+	default:
+		return false
+	}
+}
+
+//countUnsent counts a test of the set that was never sent because the run was interrupted
+func (a *Application) countUnsent(setName string) {
+	a.resultMutex.Lock()
+	defer a.resultMutex.Unlock()
+	if counts := a.Results.SetCounts[setName]; counts != nil {
+		counts.UnsentCount++
+	}
+}
+
+//wait blocks until the next test request may be sent to the target, paced by the limiter of the
+//target or the rate limiter of the application. It returns false if the run was interrupted first.
+func (a *Application) wait(target *Target) bool {
+	if target.Limiter != nil {
+		return target.Limiter.Wait(a.StopChan) && !a.stopped()
+	}
+	if a.RateLimiter != nil {
+		select {
+		case <-a.RateLimiter.C:
+		case <-a.StopChan:
+			return false
+		}
+	}
+	return !a.stopped()
+}
+
+//recordRates saves the effective rate and the throttled responses of every rate limited set in its counts
+func (a *Application) recordRates() {
+	for name, target := range a.Targets {
+		if target.Limiter == nil || a.Results.SetCounts[name] == nil {
+			continue
+		}
+		rate := target.Limiter.Rate()
+		a.Results.SetCounts[name].EffectiveRate = math.Round(rate*100) / 100
+		a.Results.SetCounts[name].ThrottledCount = target.Limiter.Throttled()
+		a.Log.Infof("%v: effective rate %.2f requests per second, %v throttled responses\n", name, rate, target.Limiter.Throttled())
+	}
 }
 
 //requestWorker is a worker that will read testRequest objects from the a.TestChan channel,
@@ -159,9 +310,14 @@ func (a *Application) requestWorker(id int, stopChan <-chan struct{}) {
 				Line:     testRequest.Line,
 				Payload:  testRequest.Payload,
 				Location: location,
+				TestType: testRequest.TestType,
+			}
+			if testRequest.TestLocation != nil {
+				testResult.Split = testRequest.TestLocation.Split
+				testResult.PaddingSize = testRequest.PaddingOffset
 			}
 			//check for invalid requests before sending
-			invalid, illegalChars, _ := checkInvalidChars(testRequest.CheckPayload, testRequest.Location)
+			invalid, illegalChars, _ := checkInvalidChars(testRequest.CheckPayload, testRequest.locationType())
 			if invalid {
 				testResult.Outcome = stringInv
 				testResult.Response = illegalChars
@@ -169,13 +325,24 @@ func (a *Application) requestWorker(id int, stopChan <-chan struct{}) {
 				a.ResultsChan <- testResult
 				continue
 			}
-			//save the request body for reporting if it isn't nil
-			var reqBody io.ReadCloser
-			if testRequest.Request.Body != nil {
-				reqBody, _ = testRequest.Request.GetBody()
+			target := a.target(setName)
+			if target.Limiter != nil && !target.Limiter.Acquire(a.StopChan) {
+				a.countUnsent(setName)
+				continue
+			}
+			//tests still paced when the run is interrupted are not sent
+			if !a.wait(target) {
+				if target.Limiter != nil {
+					target.Limiter.Release()
+				}
+				a.countUnsent(setName)
+				continue
+			}
+			resp, attempts, err := a.sendRetry(testRequest)
+			if target.Limiter != nil {
+				target.Limiter.Release()
 			}
-			<-a.RateLimiter.C
-			resp, err := a.Client.Do(testRequest.Request)
+			testResult.Attempts = attempts
 			//if there is an error transacting the request, save the error and
 			//push the invalid result to a.ResultsChan
 			if err != nil {
@@ -192,8 +359,10 @@ func (a *Application) requestWorker(id int, stopChan <-chan struct{}) {
 				continue
 			}
 			testRequest.Response = resp
-			//restore request body after Do() drains it
-			testRequest.Request.Body = reqBody
+			//restore request body after Do() drains it, including values set by the prefetch step
+			if testRequest.Request.GetBody != nil {
+				testRequest.Request.Body, _ = testRequest.Request.GetBody()
+			}
 			//get outcome to see if test passed or not
 			testOutcome, err := getOutcome(testRequest)
 			if err != nil {
@@ -212,11 +381,14 @@ func (a *Application) requestWorker(id int, stopChan <-chan struct{}) {
 				a.ResultsChan <- testResult
 				continue
 			}
-			//record results of all non-passed tests
+			testResult.Outcome = testOutcome
+			testResult.Desync = testRequest.Desync
+			//record the request and response of all non-passed tests
 			if testOutcome != stringPass {
-				testResult.Outcome = testOutcome
+				testResult.CloseCode = testRequest.CloseCode
+				testResult.Message = testRequest.Message
 				//get request body
-				request, err := httputil.DumpRequestOut(testRequest.Request, true)
+				request, err := testRequest.dump()
 				if err != nil {
 					resp.Body.Close()
 					testRequest.Response.Body.Close()
@@ -251,17 +423,9 @@ func (a *Application) requestWorker(id int, stopChan <-chan struct{}) {
 				}
 
 				testResult.Response = string(response)
-				a.ResultsChan <- testResult
-			}
-			//increment the total test count
-			resultMapMutext.Lock()
-			if testRequest.TestType == "falsePositive" {
-				a.Results.SetCounts[setName].TotalFPTestCount++
-			}
-			if testRequest.TestType == "falseNegative" {
-				a.Results.SetCounts[setName].TotalFNTestCount++
 			}
-			resultMapMutext.Unlock()
+			//passed tests are still counted by the result workers
+			a.ResultsChan <- testResult
 			a.Log.Debugf("request worker %v done\n", id)
 		}
 	}
@@ -277,9 +441,10 @@ func (a *Application) resultWorker(id int, stopChan <-chan struct{}) {
 	}()
 	for {
 		select {
-		//handle an interrupt such as ctrl+c
+		//an interrupt such as ctrl+c stops the request workers, the results of the requests
+		//in flight are still saved until processing is done
 		case <-stopChan:
-			return
+			stopChan = nil
 		//when all tests have run and been pushed onto the
 		//a.ResultsChan this signal cannel will close
 		case <-a.DoneProcessingChan:
@@ -291,33 +456,13 @@ func (a *Application) resultWorker(id int, stopChan <-chan struct{}) {
 		case testResult := <-a.ResultsChan:
 			a.Log.Debugf("result worker %v processing %v result %v from line %v in file %v against location %v\n", id, testResult.Outcome, testResult.Payload, testResult.Line, testResult.FileName, testResult.Location)
 			setName := testResult.SetName
-			fileName := testResult.FileName
-			location := testResult.Location
-			line := testResult.Line
+			//the counts, the saved result and the completion of a test are recorded together so
+			//a checkpoint never holds part of a test
+			a.resultMutex.Lock()
+			a.countResult(testResult)
 			//only save tests that dont have a "pass" outcome
 			if testResult.Outcome != stringPass {
-				resultMapMutext.Lock()
-				//create the maps if first time saving to one
-				if a.Results.FileResults[fileName].PayloadResults[line] == nil {
-					a.Results.FileResults[fileName].PayloadResults[line] = &results.PayloadResult{
-						Line:       line,
-						Payload:    testResult.Payload,
-						SetResults: make(map[string]*results.SetResult),
-					}
-				}
-				if a.Results.FileResults[fileName].PayloadResults[line].SetResults[setName] == nil {
-					a.Results.FileResults[fileName].PayloadResults[line].SetResults[setName] = &results.SetResult{
-						Locations: make(map[string]*results.TestResult),
-					}
-				}
-				if a.Results.FileResults[fileName].PayloadResults[line].SetResults[setName].Locations[location] == nil {
-					a.Results.FileResults[fileName].PayloadResults[line].SetResults[setName].Locations[location] = &results.TestResult{}
-				}
-				if !intContains(a.Results.FileResults[fileName].FailedLines, line) {
-					a.Results.FileResults[fileName].FailedLines = append(a.Results.FileResults[fileName].FailedLines, line)
-				}
-				//save the result
-				a.Results.FileResults[fileName].PayloadResults[line].SetResults[setName].Locations[location] = testResult
+				a.saveResult(testResult)
 				//increment the correct counter
 				switch testResult.Outcome {
 				case stringFN:
@@ -329,75 +474,178 @@ func (a *Application) resultWorker(id int, stopChan <-chan struct{}) {
 				case stringErr:
 					a.Results.SetCounts[setName].ErrCount++
 				}
-				resultMapMutext.Unlock()
-				a.Log.Debugf("result worker %v done\n", id)
 			}
+			a.complete(testResult)
+			a.resultMutex.Unlock()
+			a.result(testResult)
+			a.Log.Debugf("result worker %v done\n", id)
 		}
 	}
 }
 
+//countResult increments the total test counts of the set of a test result. Invalid tests and errors
+//are not counted as their response was never checked against the conditions of the set.
+func (a *Application) countResult(testResult *results.TestResult) {
+	counts := a.Results.SetCounts[testResult.SetName]
+	if testResult.Attempts > 1 {
+		counts.RetriedCount++
+	}
+	if testResult.Outcome == stringInv || testResult.Outcome == stringErr {
+		return
+	}
+	if testResult.Desync != "" {
+		counts.DesyncCount++
+	}
+	switch testResult.TestType {
+	case stringFP:
+		counts.TotalFPTestCount++
+	case stringFN:
+		counts.TotalFNTestCount++
+		if testResult.PaddingSize > 0 {
+			counts.AddPaddingResult(testResult.PaddingSize, testResult.Outcome == stringPass)
+		}
+	}
+}
+
+//saveResult adds a test result to the file results, the caller holds a.resultMutex
+func (a *Application) saveResult(testResult *results.TestResult) {
+	setName := testResult.SetName
+	fileName := testResult.FileName
+	location := testResult.Location
+	line := testResult.Line
+	//create the maps if first time saving to one
+	if a.Results.FileResults[fileName].PayloadResults[line] == nil {
+		a.Results.FileResults[fileName].PayloadResults[line] = &results.PayloadResult{
+			Line:       line,
+			Payload:    testResult.Payload,
+			SetResults: make(map[string]*results.SetResult),
+		}
+	}
+	if a.Results.FileResults[fileName].PayloadResults[line].SetResults[setName] == nil {
+		a.Results.FileResults[fileName].PayloadResults[line].SetResults[setName] = &results.SetResult{
+			Locations: make(map[string]*results.TestResult),
+		}
+	}
+	if !intContains(a.Results.FileResults[fileName].FailedLines, line) {
+		a.Results.FileResults[fileName].FailedLines = append(a.Results.FileResults[fileName].FailedLines, line)
+	}
+	//save the result
+	a.Results.FileResults[fileName].PayloadResults[line].SetResults[setName].Locations[location] = testResult
+}
+
+//out returns the writer of the progress messages
+func (a *Application) out() io.Writer {
+	if a.Out == nil {
+		return ioutil.Discard
+	}
+	return a.Out
+}
+
 //queueTests reads through all the payload files for the entire test run and creates and enqueues
 //testRequest objects that will be used to run individual tests against the application
-func (a *Application) queueTests() {
+func (a *Application) queueTests() error {
+	//the workers stop once the tests queued are drained, even when queuing fails
+	defer close(a.DoneQueuingChan)
 	testRun := a.TestRun
 	//for each file
 	for _, file := range testRun.TestFiles {
-		fmt.Printf("processsing %v...\n", file.File)
+		fmt.Fprintf(a.out(), "processsing %v...\n", file.File)
 		a.Log.Infof("processsing %v...\n", file.File)
 		payloadFile, err := os.Open(file.File)
 		if err != nil {
-			fmt.Println("error running tests. Check log for details")
-			a.Log.Fatalf("unable to open file %v: %v", file.File, err)
+			return fmt.Errorf("unable to open file %v: %v", file.File, err)
 		}
 		scanner := bufio.NewScanner(payloadFile)
 		line := 1
+		parts := strings.Split(file.File, string(os.PathSeparator))
+		parentDir := parts[len(parts)-2]
+		fileName := parentDir + string(os.PathSeparator) + filepath.Base(file.File)
 
 		bar := progressbar.NewOptions(-1,
 			progressbar.OptionSetDescription("processing lines..."),
 			progressbar.OptionSpinnerType(14),
-			progressbar.OptionShowCount())
+			progressbar.OptionShowCount(),
+			progressbar.OptionSetWriter(a.out()))
 		//for each line in the file
 		for scanner.Scan() {
 			bar.Add(1)
 			//for each testSet
 			for _, testSet := range a.TestRun.TestSets {
 				//for each location specified by the configurations
-				for _, location := range testRun.Locations {
-					parts := strings.Split(file.File, string(os.PathSeparator))
-					parentDir := parts[len(parts)-2]
+				for _, location := range a.locations(testSet) {
+					if a.skipTest(location, testSet, file.TestType) {
+						continue
+					}
+					//tests completed before the run was resumed are not sent again
+					if a.isCompleted(testKey{File: fileName, Set: testSet.Name, Location: location.Label(), Line: line}) {
+						continue
+					}
+					//once the run is interrupted the remaining tests are counted without being built or sent
+					if a.stopped() {
+						a.countUnsent(testSet.Name)
+						continue
+					}
 					//build testRequest object
 					testRequest := &TestRequest{
-						SetName:  testSet.Name,
-						Location: location.Location,
-						FileName: parentDir + string(os.PathSeparator) + filepath.Base(file.File),
-						TestType: file.TestType,
-						Line:     line,
-						Payload:  scanner.Text(),
-						AllowCon: testSet.AllowCondition,
-						BlockCon: testSet.BlockCondition,
+						SetName:      testSet.Name,
+						Location:     location.Label(),
+						TestLocation: location,
+						FileName:     fileName,
+						TestType:     file.TestType,
+						Line:         line,
+						Payload:      scanner.Text(),
+						AllowCon:     testSet.AllowCondition,
+						BlockCon:     testSet.BlockCondition,
 					}
 					//adjust the request to include the payload in the correct location
 					err = a.buildRequest(testRequest, location, testSet)
 					if err != nil {
 						payloadFile.Close()
-						fmt.Println("Error building request. Check log for details")
-						a.Log.Fatalf("unable to build request: %v", err)
+						return fmt.Errorf("unable to build request: %v", err)
 					}
 					//place the request on the a.TestsChan channel
-					a.TestsChan <- testRequest
+					a.testQueued(testRequest)
+					select {
+					case a.TestsChan <- testRequest:
+					case <-a.StopChan:
+						a.countUnsent(testSet.Name)
+					}
 				}
 			}
 			line++
 		}
 		//all lines of the file have been processed
 		payloadFile.Close()
-		fmt.Println("finished")
+		fmt.Fprintln(a.out(), "finished")
 		a.Log.Infof("finished processsing %v\n", file.File)
 	}
-	close(a.DoneQueuingChan)
 	//all tests are done being written to a.TestsChan, but we can't close it because reads could still be going on
-	fmt.Println("finished queuing tests")
+	fmt.Fprintln(a.out(), "finished queuing tests")
 	a.Log.Infof("finished queuing tests")
+	return nil
+}
+
+//locations returns the locations tested against a test set, which are the locations of the
+//test run unless the set has its own such as the operations of an OpenAPI document
+func (a *Application) locations(testSet *config.TestSet) []*config.TestLocation {
+	if len(testSet.Locations) > 0 {
+		return testSet.Locations
+	}
+	return a.TestRun.Locations
+}
+
+//skipTest returns true for locations that do not apply to a test set or type of test
+func (a *Application) skipTest(location *config.TestLocation, testSet *config.TestSet, testType string) bool {
+	loc := strings.ToLower(location.Location)
+	//desync probes are attacks in themselves so blocking them is never a false positive
+	if loc == "smuggling" && testType == stringFP {
+		return true
+	}
+	//HTTP/2 vectors and native gRPC can only be sent to WAFs speaking HTTP/2
+	if (strings.HasPrefix(loc, "h2_") || loc == "grpc") && !a.target(testSet.Name).http2() {
+		return true
+	}
+	return false
 }
 
 //getOutcome looks to see if the response received indicates a passed of failed test
@@ -411,6 +659,41 @@ func getOutcome(testRequest *TestRequest) (string, error) {
 	if resp == nil {
 		return stringInv, nil
 	}
+	//once the websocket handshake succeeded the block decision is made on how the server answered the message
+	if testRequest.locationType() == "websocket" && resp.StatusCode == http.StatusSwitchingProtocols {
+		blocked := webSocketBlocked(testRequest)
+		switch testType {
+		case stringFN:
+			if !blocked {
+				return stringFN, nil
+			}
+			return stringPass, nil
+		case stringFP:
+			if blocked {
+				return stringFP, nil
+			}
+			return stringPass, nil
+		}
+	}
+	//gRPC requests are also blocked through the grpc-status and the trailers
+	if loc := testRequest.locationType(); loc == "grpc" || loc == "grpc_web" {
+		blocked := grpcBlocked(testRequest)
+		switch testType {
+		case stringFN:
+			if !blocked {
+				return stringFN, nil
+			}
+			return stringPass, nil
+		case stringFP:
+			if blocked {
+				return stringFP, nil
+			}
+			if len(allowCon.Headers) > 0 && !headerCheck(allowCon.Headers, resp) && !headerCheck(allowCon.Headers, &http.Response{Header: resp.Trailer}) {
+				return stringFP, nil
+			}
+			return stringPass, nil
+		}
+	}
 	switch testType {
 	//actual = allow, expected == block
 	case stringFN:
@@ -464,7 +747,29 @@ func defaultRequest(testSet *config.TestSet, method string, data io.Reader) (*ht
 
 //buildRequest places the payload in the correct part of the request depending on the test location
 func (a *Application) buildRequest(testRequest *TestRequest, location *config.TestLocation, testSet *config.TestSet) error {
-	req, err := defaultRequest(testSet, http.MethodGet, nil)
+	//the steps of a scenario are built on the default request when they are sent
+	if location.Scenario != nil {
+		req, err := defaultRequest(testSet, http.MethodGet, nil)
+		if err != nil {
+			return err
+		}
+		testRequest.Request = req
+		return nil
+	}
+	if location.Template != nil {
+		if err := a.buildTemplateRequest(testRequest, location, testSet); err != nil {
+			return err
+		}
+		return finishRequest(testRequest, location)
+	}
+	method := requestMethod(location)
+	if location.Split != "" {
+		if err := a.buildSplitRequest(testRequest, location, testSet, method); err != nil {
+			return err
+		}
+		return finishRequest(testRequest, location)
+	}
+	req, err := defaultRequest(testSet, method, nil)
 	if err != nil {
 		return err
 	}
@@ -483,7 +788,6 @@ func (a *Application) buildRequest(testRequest *TestRequest, location *config.Te
 			testRequest.CheckPayload = testRequest.Payload
 		}
 		testRequest.Request.ContentLength = int64(0)
-		return nil
 	case "path":
 		testRequest.Request = req
 		//url encoded path
@@ -499,7 +803,6 @@ func (a *Application) buildRequest(testRequest *TestRequest, location *config.Te
 			}
 			testRequest.CheckPayload = testRequest.Payload
 		}
-		return nil
 	case "queryarg":
 		testRequest.Request = req
 		if a.TestRun.URLEncodeQuery {
@@ -511,13 +814,12 @@ func (a *Application) buildRequest(testRequest *TestRequest, location *config.Te
 			testRequest.Request.URL.RawQuery = fmt.Sprintf("%s=%s", location.Key, testRequest.Payload)
 			testRequest.CheckPayload = testRequest.Payload
 		}
-		return nil
 	case "body":
 		//url encoded body
 		if a.TestRun.PostBodyType == "urlencoded" {
 			data := &url.Values{}
 			data.Add(location.Key, testRequest.Payload)
-			postReq, err := defaultRequest(testSet, http.MethodPost, strings.NewReader(data.Encode()))
+			postReq, err := defaultRequest(testSet, method, strings.NewReader(data.Encode()))
 			if err != nil {
 				return err
 			}
@@ -528,7 +830,7 @@ func (a *Application) buildRequest(testRequest *TestRequest, location *config.Te
 		} else if a.TestRun.PostBodyType == "json" {
 			//json body
 			jsonStr := []byte(`{"` + location.Key + `":"` + testRequest.Payload + `"}`)
-			postReq, err := defaultRequest(testSet, http.MethodPost, bytes.NewBuffer(jsonStr))
+			postReq, err := defaultRequest(testSet, method, bytes.NewBuffer(jsonStr))
 			if err != nil {
 				return err
 			}
@@ -539,7 +841,7 @@ func (a *Application) buildRequest(testRequest *TestRequest, location *config.Te
 		} else {
 			//raw body
 			postStr := []byte(location.Key + "=" + testRequest.Payload)
-			postReq, err := defaultRequest(testSet, http.MethodPost, bytes.NewBuffer(postStr))
+			postReq, err := defaultRequest(testSet, method, bytes.NewBuffer(postStr))
 			if err != nil {
 				return err
 			}
@@ -548,7 +850,13 @@ func (a *Application) buildRequest(testRequest *TestRequest, location *config.Te
 			testRequest.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 			testRequest.CheckPayload = testRequest.Payload
 		}
-		return nil
+		if location.PaddingSize > 0 {
+			offset, err := padBody(testRequest.Request, location.PaddingSize)
+			if err != nil {
+				return err
+			}
+			testRequest.PaddingOffset = offset
+		}
 	case "cookie":
 		testRequest.Request = req
 		//encoded cookies are base64 encoded per the recommendation of RFC 6265 section 4.1.1
@@ -561,10 +869,357 @@ func (a *Application) buildRequest(testRequest *TestRequest, location *config.Te
 			testRequest.Request.Header.Add("Cookie", fmt.Sprintf(`%v=%v`, location.Key, testRequest.Payload))
 			testRequest.CheckPayload = testRequest.Payload
 		}
-		return nil
+	case "header_name":
+		testRequest.Request = req
+		name := testRequest.Payload
+		if a.TestRun.URLEncodeHeader {
+			name = url.QueryEscape(testRequest.Payload)
+		}
+		//set the header directly so the name is sent as-is rather than canonicalized
+		testRequest.Request.Header[name] = []string{nameValue(location)}
+		testRequest.CheckPayload = name
+		testRequest.Request.ContentLength = int64(0)
+	case "queryarg_name":
+		testRequest.Request = req
+		if a.TestRun.URLEncodeQuery {
+			testRequest.CheckPayload = url.QueryEscape(testRequest.Payload)
+		} else {
+			testRequest.CheckPayload = testRequest.Payload
+		}
+		testRequest.Request.URL.RawQuery = fmt.Sprintf("%s=%s", testRequest.CheckPayload, url.QueryEscape(nameValue(location)))
+	case "body_name":
+		var body []byte
+		contentType := "application/x-www-form-urlencoded"
+		if a.TestRun.PostBodyType == "urlencoded" {
+			data := &url.Values{}
+			data.Add(testRequest.Payload, nameValue(location))
+			body = []byte(data.Encode())
+			testRequest.CheckPayload = url.QueryEscape(testRequest.Payload)
+		} else if a.TestRun.PostBodyType == "json" {
+			body = []byte(`{"` + testRequest.Payload + `":"` + nameValue(location) + `"}`)
+			contentType = "application/json"
+			testRequest.CheckPayload = testRequest.Payload
+		} else {
+			body = []byte(testRequest.Payload + "=" + nameValue(location))
+			testRequest.CheckPayload = testRequest.Payload
+		}
+		postReq, err := defaultRequest(testSet, method, bytes.NewBuffer(body))
+		if err != nil {
+			return err
+		}
+		testRequest.Request = postReq
+		testRequest.Request.Close = true
+		testRequest.Request.Header.Set("Content-Type", contentType)
+	case "cookie_name":
+		testRequest.Request = req
+		if a.TestRun.B64EncodeCookie {
+			testRequest.CheckPayload = base64.RawStdEncoding.EncodeToString([]byte(testRequest.Payload))
+		} else {
+			testRequest.CheckPayload = testRequest.Payload
+		}
+		testRequest.Request.Header.Add("Cookie", fmt.Sprintf(`%v=%v`, testRequest.CheckPayload, nameValue(location)))
+	case "h2_header":
+		testRequest.Request = req
+		testRequest.Request.Header.Del(location.Key)
+		//set the header directly so the configured case of the name is kept
+		testRequest.Request.Header[location.Key] = []string{testRequest.Payload}
+		testRequest.CheckPayload = ""
+	case "h2_pseudoheader":
+		//the pseudo-header carrying the payload is written when the request is sent
+		testRequest.Request = req
+		testRequest.CheckPayload = ""
+	case "websocket":
+		//the handshake and the message carrying the payload are written when the request is sent
+		testRequest.Request = req
+		if location.Key != "" {
+			testRequest.Request.URL.Path = strings.TrimSuffix(req.URL.Path, "/") + "/" + strings.TrimPrefix(location.Key, "/")
+		}
+		testRequest.CheckPayload = ""
+	case "grpc", "grpc_web":
+		message, err := grpcMessage(location, testRequest.Payload)
+		if err != nil {
+			return err
+		}
+		postReq, err := defaultRequest(testSet, http.MethodPost, bytes.NewBuffer(grpcFrame(message)))
+		if err != nil {
+			return err
+		}
+		testRequest.Request = postReq
+		testRequest.Request.Close = true
+		testRequest.Request.URL.Path = strings.TrimSuffix(postReq.URL.Path, "/") + "/" + location.RPC
+		if strings.ToLower(location.Location) == "grpc" {
+			testRequest.Request.Header.Set("Content-Type", "application/grpc")
+			testRequest.Request.Header.Set("Te", "trailers")
+		} else {
+			testRequest.Request.Header.Set("Content-Type", "application/grpc-web+proto")
+			testRequest.Request.Header.Set("X-Grpc-Web", "1")
+		}
+		testRequest.CheckPayload = ""
+	case "smuggling":
+		//the probe carrying the payload is built when the request is sent
+		postReq, err := defaultRequest(testSet, http.MethodPost, nil)
+		if err != nil {
+			return err
+		}
+		testRequest.Request = postReq
+		testRequest.Request.Close = true
+		testRequest.CheckPayload = ""
 	default:
 		return fmt.Errorf("Unknown location: %v", location.Location)
 	}
+	return finishRequest(testRequest, location)
+}
+
+//finishRequest applies the options of the location that act on the request as a whole
+//once the payload has been placed
+func finishRequest(testRequest *TestRequest, location *config.TestLocation) error {
+	req := testRequest.Request
+	length := req.ContentLength
+	if err := applyMethodOverride(req, location); err != nil {
+		return err
+	}
+	//the method override is placed before the payload parameter of a padded body, moving it
+	//further into the body
+	if testRequest.PaddingOffset > 0 {
+		testRequest.PaddingOffset += int(req.ContentLength - length)
+	}
+	return encodeBody(req, location.ContentEncoding)
+}
+
+//buildSplitRequest spreads the payload over duplicate parameters of the location according to the
+//split strategy: a benign value followed by the payload, the payload split in two halves, or the
+//halves mixed between the query and the body
+func (a *Application) buildSplitRequest(testRequest *TestRequest, location *config.TestLocation, testSet *config.TestSet, method string) error {
+	values := splitPayload(testRequest.Payload, location.Split)
+	loc := strings.ToLower(location.Location)
+	if location.Split == "mixed" {
+		//the second half always travels in a form body so the request needs a method that carries one
+		if location.Method == "" {
+			method = http.MethodPost
+		}
+		query := a.splitQuery(location.Key, values[:1])
+		body := a.splitForm(location.Key, values[1:])
+		req, err := defaultRequest(testSet, method, strings.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Close = true
+		req.URL.RawQuery = query
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		testRequest.Request = req
+		testRequest.CheckPayload = query
+		return nil
+	}
+	switch loc {
+	case "queryarg":
+		req, err := defaultRequest(testSet, method, nil)
+		if err != nil {
+			return err
+		}
+		req.Close = true
+		req.URL.RawQuery = a.splitQuery(location.Key, values)
+		testRequest.Request = req
+		testRequest.CheckPayload = req.URL.RawQuery
+	case "body":
+		var body string
+		contentType := "application/x-www-form-urlencoded"
+		if a.TestRun.PostBodyType == "json" {
+			//duplicate keys are valid JSON syntax but parsers disagree on which one wins
+			var fields []string
+			for _, v := range values {
+				fields = append(fields, `"`+location.Key+`":"`+v+`"`)
+			}
+			body = "{" + strings.Join(fields, ",") + "}"
+			contentType = "application/json"
+			testRequest.CheckPayload = testRequest.Payload
+		} else {
+			body = a.splitForm(location.Key, values)
+			testRequest.CheckPayload = body
+		}
+		req, err := defaultRequest(testSet, method, strings.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Close = true
+		req.Header.Set("Content-Type", contentType)
+		testRequest.Request = req
+	case "cookie":
+		req, err := defaultRequest(testSet, method, nil)
+		if err != nil {
+			return err
+		}
+		req.Close = true
+		var cookies, check []string
+		for _, v := range values {
+			if a.TestRun.B64EncodeCookie {
+				v = base64.RawStdEncoding.EncodeToString([]byte(v))
+			}
+			cookies = append(cookies, location.Key+"="+v)
+			check = append(check, v)
+		}
+		req.Header.Add("Cookie", strings.Join(cookies, "; "))
+		testRequest.Request = req
+		testRequest.CheckPayload = strings.Join(check, "")
+	case "header":
+		req, err := defaultRequest(testSet, method, nil)
+		if err != nil {
+			return err
+		}
+		req.Close = true
+		req.Header.Del(location.Key)
+		var check []string
+		for _, v := range values {
+			if a.TestRun.URLEncodeHeader {
+				v = url.QueryEscape(v)
+			}
+			//each value is written on its own header line
+			req.Header.Add(location.Key, v)
+			check = append(check, v)
+		}
+		req.ContentLength = int64(0)
+		testRequest.Request = req
+		testRequest.CheckPayload = strings.Join(check, "")
+	default:
+		return fmt.Errorf("split %v is not supported for location %v", location.Split, location.Location)
+	}
+	return nil
+}
+
+//splitPayload returns the values sent for each duplicate parameter of a split strategy
+func splitPayload(payload string, split string) []string {
+	if split == "duplicate" {
+		return []string{"1", payload}
+	}
+	//split on a rune boundary so multi-byte characters are not broken in half
+	runes := []rune(payload)
+	half := len(runes) / 2
+	return []string{string(runes[:half]), string(runes[half:])}
+}
+
+//splitQuery builds a query string assigning each value to the same key
+func (a *Application) splitQuery(key string, values []string) string {
+	var params []string
+	for _, v := range values {
+		if a.TestRun.URLEncodeQuery {
+			v = url.QueryEscape(v)
+		}
+		params = append(params, key+"="+v)
+	}
+	return strings.Join(params, "&")
+}
+
+//splitForm builds a form body assigning each value to the same key
+func (a *Application) splitForm(key string, values []string) string {
+	var params []string
+	for _, v := range values {
+		if a.TestRun.PostBodyType == "urlencoded" {
+			v = url.QueryEscape(v)
+		}
+		params = append(params, key+"="+v)
+	}
+	return strings.Join(params, "&")
+}
+
+//nameValue returns the value assigned to a parameter when the payload is used as the parameter name.
+//The key of a name location holds this value and defaults to "foo".
+func nameValue(location *config.TestLocation) string {
+	if location.Key == "" {
+		return "foo"
+	}
+	return location.Key
+}
+
+//requestMethod returns the method a request for the location is sent with. Requests using a
+//method override are always sent as POST since that is the only method most frameworks honor it on.
+func requestMethod(location *config.TestLocation) string {
+	if location.MethodOverride != "" {
+		return http.MethodPost
+	}
+	if location.Method != "" {
+		return location.Method
+	}
+	if loc := strings.ToLower(location.Location); loc == "body" || loc == "body_name" {
+		return http.MethodPost
+	}
+	return http.MethodGet
+}
+
+//applyMethodOverride declares the configured method of the location through the configured
+//method override mechanism: the X-HTTP-Method-Override header or a _method query or body parameter
+func applyMethodOverride(req *http.Request, location *config.TestLocation) error {
+	switch location.MethodOverride {
+	case "":
+		return nil
+	case "header":
+		req.Header.Set("X-HTTP-Method-Override", location.Method)
+	case "query":
+		param := "_method=" + url.QueryEscape(location.Method)
+		if req.URL.RawQuery == "" {
+			req.URL.RawQuery = param
+		} else {
+			req.URL.RawQuery = param + "&" + req.URL.RawQuery
+		}
+	case "body":
+		var body []byte
+		if req.Body != nil {
+			data, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return err
+			}
+			body = data
+		}
+		if req.Header.Get("Content-Type") == "application/json" && bytes.HasPrefix(body, []byte("{")) {
+			body = append([]byte(`{"_method":"`+location.Method+`",`), body[1:]...)
+		} else {
+			param := "_method=" + url.QueryEscape(location.Method)
+			if len(body) > 0 {
+				param += "&"
+			}
+			body = append([]byte(param), body...)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		setBody(req, body)
+	default:
+		return fmt.Errorf("unknown method override: %v", location.MethodOverride)
+	}
+	return nil
+}
+
+//padBody prepends a benign parameter to the body so the payload parameter starts size bytes into the body.
+//It returns the offset of the payload parameter, measured in the padded body.
+func padBody(req *http.Request, size int) (int, error) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return 0, err
+	}
+	isJSON := req.Header.Get("Content-Type") == "application/json" && bytes.HasPrefix(body, []byte("{"))
+	if wrapper := config.PaddingWrapper(isJSON); size < wrapper {
+		return 0, fmt.Errorf("padding of %v bytes is smaller than the %v bytes of the pad parameter", size, wrapper)
+	}
+	if isJSON {
+		//{"pad":"aaa...", accounts for 10 bytes of the padding
+		padded := append([]byte(`{"pad":"`+padding(size-10)+`",`), body[1:]...)
+		setBody(req, padded)
+		return len(padded) - (len(body) - 1), nil
+	}
+	//pad=aaa...& accounts for 5 bytes of the padding
+	padded := append([]byte("pad="+padding(size-5)+"&"), body...)
+	setBody(req, padded)
+	return len(padded) - len(body), nil
+}
+
+//padding returns n bytes of benign filler
+func padding(n int) string {
+	return strings.Repeat("a", n)
+}
+
+//setBody replaces the body of the request, keeping the content length and GetBody in sync
+func setBody(req *http.Request, body []byte) {
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
 }
 
 //headerCheck looks to see if the response contains the headers and values designated
@@ -122,7 +122,9 @@ func TestRequestWoker(t *testing.T) {
 		FileName: "fp.txt",
 		Line:     1,
 		Payload:  "LOCK AND KEY",
+		TestType: "falsePositive",
 		Outcome:  "falsePositive",
+		Attempts: 1,
 		Request:  string(wantRequest),
 		Response: "HTTP/0.0 406 Not Acceptable\r\nContent-Length: 0\r\n\r\n",
 	}
@@ -266,6 +268,7 @@ func TestQueueTests(t *testing.T) {
 		Line:         1,
 		Payload:      "LOCK AND KEY",
 		CheckPayload: "LOCK AND KEY",
+		TestLocation: testRun.Locations[0],
 		AllowCon:     &config.Condition{Code: 200, Headers: nil},
 		BlockCon:     &config.Condition{Code: 406, Headers: nil},
 		Request: &http.Request{
@@ -292,7 +295,9 @@ func TestQueueTests(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.app.queueTests()
+			if err := tt.app.queueTests(); err != nil {
+				t.Fatal(err)
+			}
 			out := <-tt.app.TestsChan
 			close(tt.app.TestsChan)
 			if ok := cmp.Equal(out, tt.want, cmpopts.IgnoreUnexported(http.Request{})); !ok {
@@ -348,6 +353,17 @@ func TestGetOutcome(t *testing.T) {
 	FPValid.Header.Add("Foo", "Bar")
 	FPValid.Header.Add("Lorem", "Ipsum")
 
+	//websocket upgraded. the block decision is made on the close code or message
+	BlockConditionWebSocket := &config.Condition{
+		Code:      406,
+		CloseCode: 1008,
+		Message:   "blocked",
+	}
+	WebSocketUpgraded := new(http.Response)
+	initResponse(WebSocketUpgraded)
+	WebSocketUpgraded.Status = "101 Switching Protocols"
+	WebSocketUpgraded.StatusCode = 101
+
 	tests := []struct {
 		name        string
 		testRequest *TestRequest
@@ -426,6 +442,43 @@ func TestGetOutcome(t *testing.T) {
 			want:    stringPass,
 			wantErr: false,
 		},
+		{
+			name: "WebSocketFNClosed",
+			testRequest: &TestRequest{
+				Response:     WebSocketUpgraded,
+				TestType:     stringFN,
+				TestLocation: &config.TestLocation{Location: "websocket"},
+				CloseCode:    1008,
+				BlockCon:     BlockConditionWebSocket,
+			},
+			want:    stringPass,
+			wantErr: false,
+		},
+		{
+			name: "WebSocketFNEchoed",
+			testRequest: &TestRequest{
+				Response:     WebSocketUpgraded,
+				TestType:     stringFN,
+				TestLocation: &config.TestLocation{Location: "websocket"},
+				Message:      "bar!",
+				BlockCon:     BlockConditionWebSocket,
+			},
+			want:    stringFN,
+			wantErr: false,
+		},
+		{
+			name: "WebSocketFPMessage",
+			testRequest: &TestRequest{
+				Response:     WebSocketUpgraded,
+				TestType:     stringFP,
+				TestLocation: &config.TestLocation{Location: "websocket"},
+				Message:      "request blocked by policy",
+				BlockCon:     BlockConditionWebSocket,
+				AllowCon:     AllowConditionHeaders,
+			},
+			want:    stringFP,
+			wantErr: false,
+		},
 		{
 			name: "Error",
 			testRequest: &TestRequest{
@@ -704,6 +757,202 @@ func TestBuildRequest(t *testing.T) {
 		Host:       "testhost",
 		Close:      true,
 	}
+	queryargWantPut := &http.Request{
+		URL: &url.URL{
+			Host:     "testhost",
+			Scheme:   "http",
+			RawQuery: "Foo=bar!",
+		},
+		Method:     "PUT",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Lorem": {"Ipsum"}},
+		Host:       "testhost",
+		Close:      true,
+	}
+	headerWantOverride := &http.Request{
+		URL: &url.URL{
+			Host:   "testhost",
+			Scheme: "http",
+		},
+		Method:     "POST",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Foo": {"bar!"}, "Lorem": {"Ipsum"}, "X-Http-Method-Override": {"DELETE"}},
+		Host:       "testhost",
+		Close:      true,
+	}
+	queryargWantOverride := &http.Request{
+		URL: &url.URL{
+			Host:     "testhost",
+			Scheme:   "http",
+			RawQuery: "_method=PATCH&Foo=bar!",
+		},
+		Method:     "POST",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Lorem": {"Ipsum"}},
+		Host:       "testhost",
+		Close:      true,
+	}
+	bodyWantOverride := &http.Request{
+		URL: &url.URL{
+			Host:   "testhost",
+			Scheme: "http",
+		},
+		Method:        "POST",
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/x-www-form-urlencoded"}, "Lorem": {"Ipsum"}},
+		ContentLength: 20,
+		Host:          "testhost",
+		Body:          ioutil.NopCloser(strings.NewReader("_method=PUT&Foo=bar!")),
+		Close:         true,
+	}
+	headerNameWant := &http.Request{
+		URL: &url.URL{
+			Host:   "testhost",
+			Scheme: "http",
+		},
+		Method:     "GET",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"bar!": {"foo"}, "Lorem": {"Ipsum"}},
+		Host:       "testhost",
+		Close:      true,
+	}
+	queryargNameWant := &http.Request{
+		URL: &url.URL{
+			Host:     "testhost",
+			Scheme:   "http",
+			RawQuery: "bar%21=Foo",
+		},
+		Method:     "GET",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Lorem": {"Ipsum"}},
+		Host:       "testhost",
+		Close:      true,
+	}
+	bodyNameJSONWant := &http.Request{
+		URL: &url.URL{
+			Host:   "testhost",
+			Scheme: "http",
+		},
+		Method:        "POST",
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}, "Lorem": {"Ipsum"}},
+		ContentLength: 14,
+		Host:          "testhost",
+		Body:          ioutil.NopCloser(strings.NewReader(`{"bar!":"Foo"}`)),
+		Close:         true,
+	}
+	cookieNameWant := &http.Request{
+		URL: &url.URL{
+			Host:   "testhost",
+			Scheme: "http",
+		},
+		Method:     "GET",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Cookie": {"bar!=Foo"}, "Lorem": {"Ipsum"}},
+		Host:       "testhost",
+		Close:      true,
+	}
+	queryargWantDuplicate := &http.Request{
+		URL: &url.URL{
+			Host:     "testhost",
+			Scheme:   "http",
+			RawQuery: "Foo=1&Foo=bar!",
+		},
+		Method:     "GET",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Lorem": {"Ipsum"}},
+		Host:       "testhost",
+		Close:      true,
+	}
+	cookieWantSplit := &http.Request{
+		URL: &url.URL{
+			Host:   "testhost",
+			Scheme: "http",
+		},
+		Method:     "GET",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Cookie": {"Foo=ba; Foo=r!"}, "Lorem": {"Ipsum"}},
+		Host:       "testhost",
+		Close:      true,
+	}
+	headerWantSplit := &http.Request{
+		URL: &url.URL{
+			Host:   "testhost",
+			Scheme: "http",
+		},
+		Method:     "GET",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Foo": {"ba", "r!"}, "Lorem": {"Ipsum"}},
+		Host:       "testhost",
+		Close:      true,
+	}
+	bodyWantMixed := &http.Request{
+		URL: &url.URL{
+			Host:     "testhost",
+			Scheme:   "http",
+			RawQuery: "Foo=ba",
+		},
+		Method:        "POST",
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/x-www-form-urlencoded"}, "Lorem": {"Ipsum"}},
+		ContentLength: 6,
+		Host:          "testhost",
+		Body:          ioutil.NopCloser(strings.NewReader("Foo=r!")),
+		Close:         true,
+	}
+	bodyWantPadded := &http.Request{
+		URL: &url.URL{
+			Host:   "testhost",
+			Scheme: "http",
+		},
+		Method:        "POST",
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/x-www-form-urlencoded"}, "Lorem": {"Ipsum"}},
+		ContentLength: 24,
+		Host:          "testhost",
+		Body:          ioutil.NopCloser(strings.NewReader("pad=aaaaaaaaaaa&Foo=bar!")),
+		Close:         true,
+	}
+	webSocketWant := &http.Request{
+		URL: &url.URL{
+			Host:   "testhost",
+			Path:   "/ws",
+			Scheme: "http",
+		},
+		Method:     "GET",
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Lorem": {"Ipsum"}},
+		Host:       "testhost",
+		Close:      true,
+	}
 	tests := []struct {
 		name        string
 		testRequest *TestRequest
@@ -855,6 +1104,199 @@ func TestBuildRequest(t *testing.T) {
 			want:    cookieWantEncoded,
 			wantErr: false,
 		},
+		{
+			name:        "queryargRequestPut",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "",
+			location: &config.TestLocation{
+				Location: "queryarg",
+				Key:      "Foo",
+				Method:   "PUT",
+			},
+			want:    queryargWantPut,
+			wantErr: false,
+		},
+		{
+			name:        "headerRequestOverride",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "",
+			location: &config.TestLocation{
+				Location:       "header",
+				Key:            "Foo",
+				Method:         "DELETE",
+				MethodOverride: "header",
+			},
+			want:    headerWantOverride,
+			wantErr: false,
+		},
+		{
+			name:        "queryargRequestOverride",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "",
+			location: &config.TestLocation{
+				Location:       "queryarg",
+				Key:            "Foo",
+				Method:         "PATCH",
+				MethodOverride: "query",
+			},
+			want:    queryargWantOverride,
+			wantErr: false,
+		},
+		{
+			name:        "bodyRequestOverride",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "raw",
+			location: &config.TestLocation{
+				Location:       "body",
+				Key:            "Foo",
+				Method:         "PUT",
+				MethodOverride: "body",
+			},
+			want:    bodyWantOverride,
+			wantErr: false,
+		},
+		{
+			name:        "headerNameRequest",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "",
+			location: &config.TestLocation{
+				Location: "header_name",
+			},
+			want:    headerNameWant,
+			wantErr: false,
+		},
+		{
+			name:        "queryargNameRequest",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     true,
+			postType:    "",
+			location: &config.TestLocation{
+				Location: "queryarg_name",
+				Key:      "Foo",
+			},
+			want:    queryargNameWant,
+			wantErr: false,
+		},
+		{
+			name:        "bodyNameJSONRequest",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "json",
+			location: &config.TestLocation{
+				Location: "body_name",
+				Key:      "Foo",
+			},
+			want:    bodyNameJSONWant,
+			wantErr: false,
+		},
+		{
+			name:        "cookieNameRequest",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "",
+			location: &config.TestLocation{
+				Location: "cookie_name",
+				Key:      "Foo",
+			},
+			want:    cookieNameWant,
+			wantErr: false,
+		},
+		{
+			name:        "queryargRequestDuplicate",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "",
+			location: &config.TestLocation{
+				Location: "queryarg",
+				Key:      "Foo",
+				Split:    "duplicate",
+			},
+			want:    queryargWantDuplicate,
+			wantErr: false,
+		},
+		{
+			name:        "cookieRequestSplit",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "",
+			location: &config.TestLocation{
+				Location: "cookie",
+				Key:      "Foo",
+				Split:    "split",
+			},
+			want:    cookieWantSplit,
+			wantErr: false,
+		},
+		{
+			name:        "headerRequestSplit",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "",
+			location: &config.TestLocation{
+				Location: "header",
+				Key:      "Foo",
+				Split:    "split",
+			},
+			want:    headerWantSplit,
+			wantErr: false,
+		},
+		{
+			name:        "bodyRequestMixed",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "raw",
+			location: &config.TestLocation{
+				Location: "body",
+				Key:      "Foo",
+				Split:    "mixed",
+			},
+			want:    bodyWantMixed,
+			wantErr: false,
+		},
+		{
+			name:        "bodyRequestPadded",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "raw",
+			location: &config.TestLocation{
+				Location:    "body",
+				Key:         "Foo",
+				PaddingSize: 16,
+			},
+			want:    bodyWantPadded,
+			wantErr: false,
+		},
+		{
+			name:        "webSocketPath",
+			testRequest: testRequest,
+			testSet:     a.TestRun.TestSets[0],
+			encoded:     false,
+			postType:    "raw",
+			location: &config.TestLocation{
+				Location: "websocket",
+				Key:      "ws",
+			},
+			want:    webSocketWant,
+			wantErr: false,
+		},
 		{
 			name:        "invalidLocation",
 			testRequest: testRequest,
@@ -904,6 +1346,51 @@ func TestBuildRequest(t *testing.T) {
 	}
 }
 
+func TestPaddingOffset(t *testing.T) {
+	tests := []struct {
+		name       string
+		postType   string
+		location   *config.TestLocation
+		want       int
+		wantPrefix string
+		wantErr    bool
+	}{
+		{name: "raw", postType: "raw", location: &config.TestLocation{Location: "body", Key: "Foo", PaddingSize: 16}, want: 16, wantPrefix: "Foo="},
+		{name: "json", postType: "json", location: &config.TestLocation{Location: "body", Key: "Foo", PaddingSize: 16}, want: 16, wantPrefix: `"Foo":`},
+		{name: "methodOverride", postType: "raw", location: &config.TestLocation{Location: "body", Key: "Foo", PaddingSize: 16, Method: "PUT", MethodOverride: "body"}, want: 28, wantPrefix: "Foo="},
+		{name: "jsonMethodOverride", postType: "json", location: &config.TestLocation{Location: "body", Key: "Foo", PaddingSize: 16, Method: "PUT", MethodOverride: "body"}, want: 32, wantPrefix: `"Foo":`},
+		{name: "smallerThanWrapper", postType: "json", location: &config.TestLocation{Location: "body", Key: "Foo", PaddingSize: 6}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := Application{TestRun: &config.TestRun{PostBodyType: tt.postType}}
+			testRequest := &TestRequest{Payload: "bar!"}
+			err := a.buildRequest(testRequest, tt.location, &config.TestSet{URI: "http://testhost"})
+			if err != nil && !tt.wantErr {
+				t.Fatal(err)
+			}
+			if err == nil && tt.wantErr {
+				t.Fatal("no expected error")
+			}
+			if err != nil {
+				return
+			}
+			if testRequest.PaddingOffset != tt.want {
+				t.Errorf("want: %v\n got: %v", tt.want, testRequest.PaddingOffset)
+			}
+			//the reported offset is where the payload parameter starts in the body sent
+			body, err := ioutil.ReadAll(testRequest.Request.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(body[testRequest.PaddingOffset:]); !strings.HasPrefix(got, tt.wantPrefix) {
+				t.Errorf("want: payload parameter at offset %v\n got: %q", testRequest.PaddingOffset, body)
+			}
+		})
+	}
+}
+
 func TestHeaderCheck(t *testing.T) {
 	baseResp, testResp1, testResp2 := new(http.Response), new(http.Response), new(http.Response)
 	initResponse(baseResp)
@@ -1022,3 +1509,38 @@ func TestIntContains(t *testing.T) {
 		})
 	}
 }
+
+func TestRunInterrupted(t *testing.T) {
+	interruptedRun := &config.TestRun{
+		Locations: []*config.TestLocation{{Location: "header", Key: "foo"}, {Location: "cookie", Key: "foo"}},
+		TestFiles: testRun.TestFiles,
+		TestSets:  testRun.TestSets,
+	}
+	stopChan := make(chan struct{})
+	close(stopChan)
+	log := logrus.New()
+	log.SetOutput(ioutil.Discard)
+	app := &Application{
+		TestRun:            interruptedRun,
+		Log:                log,
+		ErrorLog:           log,
+		Results:            results.InitResults(interruptedRun),
+		TestsChan:          make(chan *TestRequest, 10),
+		ResultsChan:        make(chan *results.TestResult, 10),
+		StopChan:           stopChan,
+		DoneQueuingChan:    make(chan struct{}, 1),
+		DoneProcessingChan: make(chan struct{}, 1),
+		WorkerLimit:        2,
+	}
+	if err := app.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !app.Results.Incomplete {
+		t.Error("want: incomplete run")
+	}
+	counts := app.Results.SetCounts["Test1"]
+	if counts.UnsentCount != 2 || counts.TotalCount != 0 {
+		t.Errorf("want: 2 tests not sent and none run\n got: %v not sent and %v run", counts.UnsentCount, counts.TotalCount)
+	}
+}
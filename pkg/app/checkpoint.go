@@ -0,0 +1,213 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/results"
+)
+
+//checkpointFile is the name of the checkpoint file in the output directory
+const checkpointFile = "checkpoint.json"
+
+//checkpointInterval is the time between saves of the checkpoint during a run
+const checkpointInterval = 30 * time.Second
+
+//testKey identifies a test by the payload file and line, the test set and the location
+type testKey struct {
+	File     string
+	Set      string
+	Location string
+	Line     int
+}
+
+//Checkpoint is the progress of a run saved to the output directory, which a run started with the
+//resume flag merges into its results
+type Checkpoint struct {
+	StartTime string
+	//Completed holds the completed lines of each payload file, by file, test set and location
+	Completed   map[string]map[string]map[string][]int
+	SetCounts   map[string]*results.SetCounts
+	FileResults map[string]*results.FileResult
+}
+
+//checkpointPath returns the path of the checkpoint file in the output directory
+func (a *Application) checkpointPath() string {
+	return filepath.Join(a.Results.Reporting.OutputDir, checkpointFile)
+}
+
+//isCompleted returns true if the test was completed by the run or the run it resumed
+func (a *Application) isCompleted(key testKey) bool {
+	a.resultMutex.RLock()
+	defer a.resultMutex.RUnlock()
+	return a.completed[key]
+}
+
+//complete records a test as completed when the run is checkpointed, the caller holds a.resultMutex
+func (a *Application) complete(testResult *results.TestResult) {
+	if a.completed == nil {
+		return
+	}
+	a.completed[testKey{File: testResult.FileName, Set: testResult.SetName, Location: testResult.Location, Line: testResult.Line}] = true
+}
+
+//startCheckpoints saves the checkpoint every checkpointInterval until the returned function is called
+func (a *Application) startCheckpoints() func() {
+	if !a.Checkpoint {
+		return func() {}
+	}
+	if a.completed == nil {
+		a.completed = make(map[testKey]bool)
+	}
+	stopChan := make(chan struct{})
+	doneChan := make(chan struct{})
+	go func() {
+		defer close(doneChan)
+		ticker := time.NewTicker(checkpointInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopChan:
+				return
+			case <-ticker.C:
+				if err := a.saveCheckpoint(); err != nil {
+					a.ErrorLog.Errorf("unable to save checkpoint: %v\n", err)
+				}
+			}
+		}
+	}()
+	return func() {
+		close(stopChan)
+		<-doneChan
+	}
+}
+
+//finishCheckpoint saves the checkpoint of an interrupted run, and removes the checkpoint of a run
+//that sent all its tests
+func (a *Application) finishCheckpoint() {
+	if !a.Checkpoint {
+		return
+	}
+	if !a.Results.Incomplete {
+		if err := os.Remove(a.checkpointPath()); err != nil && !os.IsNotExist(err) {
+			a.ErrorLog.Errorf("unable to remove checkpoint: %v\n", err)
+		}
+		return
+	}
+	if err := a.saveCheckpoint(); err != nil {
+		fmt.Fprintf(a.out(), "unable to save checkpoint: %v\n", err)
+		a.ErrorLog.Errorf("unable to save checkpoint: %v\n", err)
+		return
+	}
+	fmt.Fprintf(a.out(), "progress saved to %v\n", a.checkpointPath())
+	a.Log.Infof("progress saved to %v\n", a.checkpointPath())
+}
+
+//saveCheckpoint writes the completed tests and the results so far to the checkpoint file. The file
+//is replaced in a single rename so a crash while saving leaves the previous checkpoint.
+func (a *Application) saveCheckpoint() error {
+	a.resultMutex.RLock()
+	checkpoint := &Checkpoint{
+		StartTime:   a.Results.StartTime,
+		Completed:   make(map[string]map[string]map[string][]int),
+		SetCounts:   a.Results.SetCounts,
+		FileResults: a.Results.FileResults,
+	}
+	for key := range a.completed {
+		if checkpoint.Completed[key.File] == nil {
+			checkpoint.Completed[key.File] = make(map[string]map[string][]int)
+		}
+		if checkpoint.Completed[key.File][key.Set] == nil {
+			checkpoint.Completed[key.File][key.Set] = make(map[string][]int)
+		}
+		checkpoint.Completed[key.File][key.Set][key.Location] = append(checkpoint.Completed[key.File][key.Set][key.Location], key.Line)
+	}
+	for _, sets := range checkpoint.Completed {
+		for _, locations := range sets {
+			for _, lines := range locations {
+				sort.Ints(lines)
+			}
+		}
+	}
+	out, err := json.Marshal(checkpoint)
+	a.resultMutex.RUnlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(a.Results.Reporting.OutputDir, os.ModePerm); err != nil {
+		return err
+	}
+	tmp := a.checkpointPath() + ".tmp"
+	if err := ioutil.WriteFile(tmp, out, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, a.checkpointPath())
+}
+
+//LoadCheckpoint merges the results saved in the checkpoint file of a previous run into the results
+//of the application, and marks the tests it completed so they are not sent again. Results of test
+//sets and payload files no longer in the test run are dropped.
+func (a *Application) LoadCheckpoint() error {
+	data, err := ioutil.ReadFile(a.checkpointPath())
+	if err != nil {
+		return fmt.Errorf("unable to read checkpoint: %v", err)
+	}
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return fmt.Errorf("invalid checkpoint %v: %v", a.checkpointPath(), err)
+	}
+	a.resultMutex.Lock()
+	defer a.resultMutex.Unlock()
+	if a.completed == nil {
+		a.completed = make(map[testKey]bool)
+	}
+	for fileName, sets := range checkpoint.Completed {
+		for setName, locations := range sets {
+			for location, lines := range locations {
+				for _, line := range lines {
+					a.completed[testKey{File: fileName, Set: setName, Location: location, Line: line}] = true
+				}
+			}
+		}
+	}
+	if checkpoint.StartTime != "" {
+		a.Results.StartTime = checkpoint.StartTime
+	}
+	for setName, counts := range checkpoint.SetCounts {
+		if a.Results.SetCounts[setName] == nil {
+			continue
+		}
+		//the tests left unsent are sent by the resumed run
+		counts.UnsentCount = 0
+		a.Results.SetCounts[setName] = counts
+	}
+	for fileName, fileResult := range checkpoint.FileResults {
+		if a.Results.FileResults[fileName] == nil {
+			continue
+		}
+		for line, payloadResult := range fileResult.PayloadResults {
+			for setName, setResult := range payloadResult.SetResults {
+				if a.Results.SetCounts[setName] == nil {
+					continue
+				}
+				for location, testResult := range setResult.Locations {
+					//the fields identifying a result are not saved with it
+					testResult.SetName = setName
+					testResult.FileName = fileName
+					testResult.Line = line
+					testResult.Payload = payloadResult.Payload
+					testResult.Location = location
+					a.saveResult(testResult)
+				}
+			}
+		}
+	}
+	fmt.Fprintf(a.out(), "resuming from %v with %v tests completed\n", a.checkpointPath(), len(a.completed))
+	a.Log.Infof("resuming from %v with %v tests completed\n", a.checkpointPath(), len(a.completed))
+	return nil
+}
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/signalsciences/waf-testing-framework/pkg/results"
+	"github.com/sirupsen/logrus"
+)
+
+func TestCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	log := logrus.New()
+	log.SetOutput(ioutil.Discard)
+	fileName := filepath.FromSlash("false_positives/fp.txt")
+
+	//an interrupted run which completed the only test of the run with a false positive
+	interrupted := &Application{
+		TestRun:    testRun,
+		Log:        log,
+		ErrorLog:   log,
+		Results:    results.InitResults(testRun),
+		Checkpoint: true,
+		completed:  make(map[testKey]bool),
+	}
+	interrupted.Results.Reporting.OutputDir = dir
+	testResult := &results.TestResult{
+		SetName:  "Test1",
+		FileName: fileName,
+		Line:     1,
+		Payload:  "LOCK AND KEY",
+		Location: "header",
+		TestType: stringFP,
+		Outcome:  stringFP,
+		Request:  "request",
+		Response: "response",
+	}
+	interrupted.countResult(testResult)
+	interrupted.saveResult(testResult)
+	interrupted.Results.SetCounts["Test1"].FpCount++
+	interrupted.Results.SetCounts["Test1"].UnsentCount = 2
+	interrupted.complete(testResult)
+	if err := interrupted.saveCheckpoint(); err != nil {
+		t.Fatalf("unable to save checkpoint: %v", err)
+	}
+
+	resumed := &Application{
+		TestRun:         testRun,
+		Log:             log,
+		ErrorLog:        log,
+		Results:         results.InitResults(testRun),
+		TestsChan:       make(chan *TestRequest, 1),
+		DoneQueuingChan: make(chan struct{}, 1),
+	}
+	resumed.Results.Reporting.OutputDir = dir
+	if err := resumed.LoadCheckpoint(); err != nil {
+		t.Fatalf("unable to load checkpoint: %v", err)
+	}
+
+	t.Run("results", func(t *testing.T) {
+		wantCounts := &results.SetCounts{FpCount: 1, TotalFPTestCount: 1}
+		if diff := cmp.Diff(wantCounts, resumed.Results.SetCounts["Test1"]); diff != "" {
+			t.Errorf("counts mismatch (-want +got):\n%s", diff)
+		}
+		//the type of a test is only used to count it and is not saved
+		if diff := cmp.Diff(interrupted.Results.FileResults, resumed.Results.FileResults, cmpopts.IgnoreFields(results.TestResult{}, "TestType")); diff != "" {
+			t.Errorf("file results mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("completed tests", func(t *testing.T) {
+		if err := resumed.queueTests(); err != nil {
+			t.Fatal(err)
+		}
+		if len(resumed.TestsChan) != 0 {
+			t.Errorf("want: no tests queued\n got: %v", len(resumed.TestsChan))
+		}
+	})
+	t.Run("finished run", func(t *testing.T) {
+		resumed.Checkpoint = true
+		resumed.finishCheckpoint()
+		if _, err := os.Stat(resumed.checkpointPath()); !os.IsNotExist(err) {
+			t.Errorf("want: checkpoint removed\n got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,181 @@
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"golang.org/x/net/http2"
+)
+
+//clientTimeout is the time allowed to connect to a WAF, and for a test request to complete unless
+//timeouts are set for the WAF
+const clientTimeout = 10 * time.Second
+
+//Target holds the connection settings used to send test requests to a single WAF
+type Target struct {
+	Set    *config.TestSet
+	Client HTTPClient
+	//Session is the authenticated session of sets with a login flow or a prefetch step
+	Session *Session
+	//Limiter paces the test requests of the set in place of the rate limiter of the application
+	Limiter *Limiter
+}
+
+//NewTarget builds the client for a test set, speaking the HTTP version configured for the set
+func NewTarget(testSet *config.TestSet) (*Target, error) {
+	t := &Target{Set: testSet}
+	client := &http.Client{
+		Timeout: t.requestTimeout(),
+	}
+	//proxies from the environment are only used by sets without a proxy of their own, which is
+	//dialed along with the resolve address by dialContext
+	envProxy := http.ProxyFromEnvironment
+	if testSet.Proxy != "" {
+		envProxy = nil
+	}
+	switch testSet.HTTPVersion {
+	case "1.1", "1.0":
+		//a non-nil empty map disables the HTTP/2 upgrade net/http negotiates over TLS.
+		//HTTP/1.0 requests are written directly to the connection and only use the client for other requests.
+		client.Transport = &http.Transport{
+			Proxy:           envProxy,
+			DialContext:     t.dialContext,
+			TLSClientConfig: testSet.TLS,
+			TLSNextProto:    map[string]func(string, *tls.Conn) http.RoundTripper{},
+		}
+	case "2":
+		client.Transport = &h2Transport{&http2.Transport{
+			TLSClientConfig: testSet.TLS,
+			DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+				return t.dialTLS(context.Background(), network, addr, cfg)
+			},
+		}}
+	case "h2c":
+		//prior knowledge h2c dials a plain connection where the transport expects a TLS one
+		client.Transport = &h2Transport{&http2.Transport{
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+				return t.dialContext(context.Background(), network, addr)
+			},
+		}}
+	default:
+		//a custom TLS configuration turns off the HTTP/2 negotiation of net/http unless forced
+		if testSet.TLS != nil || testSet.Resolve != "" || testSet.Proxy != "" {
+			client.Transport = &http.Transport{
+				Proxy:             envProxy,
+				DialContext:       t.dialContext,
+				TLSClientConfig:   testSet.TLS,
+				ForceAttemptHTTP2: true,
+			}
+		}
+	}
+	t.Client = client
+	if testSet.Login != nil || testSet.Prefetch != nil {
+		t.Session = newSession(testSet.Login, testSet.URI, client.Transport, client.Timeout)
+	}
+	if testSet.Login != nil {
+		//a session expiring with a redirect to the login page is only seen when redirects are not followed
+		if expired := testSet.Login.Expired; expired != nil && expired.Code >= 300 && expired.Code < 400 {
+			client.CheckRedirect = func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			}
+		}
+	}
+	return t, nil
+}
+
+//h2Transport sends requests over HTTP/2 without closing the connection after each request.
+//The HTTP/2 transport stalls on requests asking for the connection to be closed, which test
+//requests always do, and closing a connection has no meaning for a single HTTP/2 stream.
+type h2Transport struct {
+	*http2.Transport
+}
+
+//RoundTrip sends the request on a pooled HTTP/2 connection
+func (t *h2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Close {
+		req = req.Clone(req.Context())
+		req.Close = false
+	}
+	return t.Transport.RoundTrip(req)
+}
+
+//http2 returns true if the target speaks HTTP/2
+func (t *Target) http2() bool {
+	return t.Set != nil && (t.Set.HTTPVersion == "2" || t.Set.HTTPVersion == "h2c")
+}
+
+//resolve returns the address to connect to for the address of a request, which is the resolve
+//address of the set for the host and port of the set URI
+func (t *Target) resolve(addr string) string {
+	if t.Set == nil || t.Set.Resolve == "" {
+		return addr
+	}
+	u, err := url.Parse(t.Set.URI)
+	if err != nil || !strings.EqualFold(u.Host, addr) {
+		return addr
+	}
+	return t.Set.Resolve
+}
+
+//connectTimeout returns the time allowed to connect to the WAF
+func (t *Target) connectTimeout() time.Duration {
+	if t.Set != nil && t.Set.ConnectTimeout > 0 {
+		return t.Set.ConnectTimeout
+	}
+	return clientTimeout
+}
+
+//readTimeout returns the time allowed to send a test request and receive its response once connected
+func (t *Target) readTimeout() time.Duration {
+	if t.Set != nil && t.Set.ReadTimeout > 0 {
+		return t.Set.ReadTimeout
+	}
+	return rawTimeout
+}
+
+//requestTimeout returns the time allowed for a request sent by the client to complete, including
+//connecting and following redirects
+func (t *Target) requestTimeout() time.Duration {
+	if t.Set == nil || (t.Set.ConnectTimeout == 0 && t.Set.ReadTimeout == 0) {
+		return clientTimeout
+	}
+	return t.connectTimeout() + t.readTimeout()
+}
+
+//tlsConfig returns the TLS configuration of connections to the host, offering the given
+//application protocols during the handshake
+func (t *Target) tlsConfig(host string, protos ...string) *tls.Config {
+	cfg := &tls.Config{}
+	if t.Set != nil && t.Set.TLS != nil {
+		cfg = t.Set.TLS.Clone()
+	}
+	if cfg.ServerName == "" {
+		cfg.ServerName = host
+	}
+	cfg.NextProtos = protos
+	return cfg
+}
+
+//proto returns the protocol version written in the request line of raw HTTP/1 requests
+func (t *Target) proto() string {
+	if t.Set != nil && t.Set.HTTPVersion == "1.0" {
+		return "HTTP/1.0"
+	}
+	return "HTTP/1.1"
+}
+
+//target returns the target of the named test set. Sets without a target of their own
+//use the application client, which is how tests inject a mock client.
+func (a *Application) target(setName string) *Target {
+	if t, ok := a.Targets[setName]; ok {
+		return t
+	}
+	return &Target{Client: a.Client}
+}
@@ -0,0 +1,201 @@
+package app
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+//clientCertificate returns a self-signed client certificate
+func clientCertificate(t *testing.T) (tls.Certificate, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "waftf client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, cert
+}
+
+func TestTargetTLS(t *testing.T) {
+	clientCert, clientX509 := clientCertificate(t)
+	var mutex sync.Mutex
+	var serverNames []string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientX509)
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			mutex.Lock()
+			serverNames = append(serverNames, hello.ServerName)
+			mutex.Unlock()
+			return nil, nil
+		},
+	}
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+
+	tests := []struct {
+		name        string
+		httpVersion string
+		tls         *tls.Config
+		err         bool
+	}{
+		{
+			name: "negotiated",
+			tls:  &tls.Config{RootCAs: rootCAs, Certificates: []tls.Certificate{clientCert}, ServerName: "example.com"},
+		},
+		{
+			name:        "http11",
+			httpVersion: "1.1",
+			tls:         &tls.Config{RootCAs: rootCAs, Certificates: []tls.Certificate{clientCert}, ServerName: "example.com"},
+		},
+		{
+			name:        "http10",
+			httpVersion: "1.0",
+			tls:         &tls.Config{RootCAs: rootCAs, Certificates: []tls.Certificate{clientCert}, ServerName: "example.com"},
+		},
+		{
+			name:        "http2",
+			httpVersion: "2",
+			tls:         &tls.Config{RootCAs: rootCAs, Certificates: []tls.Certificate{clientCert}, ServerName: "example.com"},
+		},
+		{
+			name: "insecure",
+			tls:  &tls.Config{InsecureSkipVerify: true, Certificates: []tls.Certificate{clientCert}, ServerName: "example.com"},
+		},
+		{
+			name: "untrusted",
+			tls:  &tls.Config{Certificates: []tls.Certificate{clientCert}, ServerName: "example.com"},
+			err:  true,
+		},
+		{
+			name: "noClientCertificate",
+			tls:  &tls.Config{RootCAs: rootCAs, ServerName: "example.com"},
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSet := &config.TestSet{Name: "waf", URI: server.URL + "/", HTTPVersion: tt.httpVersion, TLS: tt.tls}
+			target, err := NewTarget(testSet)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a := &Application{Targets: map[string]*Target{"waf": target}}
+			req, err := defaultRequest(testSet, http.MethodGet, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mutex.Lock()
+			serverNames = nil
+			mutex.Unlock()
+			resp, err := a.send(&TestRequest{SetName: "waf", Request: req})
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v", err)
+			}
+			if err != nil {
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("want: 200\n got: %v", resp.StatusCode)
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			if len(serverNames) == 0 || serverNames[0] != "example.com" {
+				t.Errorf("want: SNI example.com\n got: %v", serverNames)
+			}
+		})
+	}
+}
+
+func TestTargetResolve(t *testing.T) {
+	var mutex sync.Mutex
+	var hosts []string
+	server := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		hosts = append(hosts, r.Host)
+		mutex.Unlock()
+	}), &http2.Server{}))
+	defer server.Close()
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		httpVersion string
+	}{
+		{name: "negotiated"},
+		{name: "http10", httpVersion: "1.0"},
+		{name: "h2c", httpVersion: "h2c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSet := &config.TestSet{
+				Name:        "waf",
+				URI:         "http://waf.example.com:" + port + "/",
+				HTTPVersion: tt.httpVersion,
+				Resolve:     server.Listener.Addr().String(),
+			}
+			target, err := NewTarget(testSet)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a := &Application{Targets: map[string]*Target{"waf": target}}
+			req, err := defaultRequest(testSet, http.MethodGet, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mutex.Lock()
+			hosts = nil
+			mutex.Unlock()
+			resp, err := a.send(&TestRequest{SetName: "waf", Request: req})
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			mutex.Lock()
+			defer mutex.Unlock()
+			if len(hosts) != 1 || hosts[0] != "waf.example.com:"+port {
+				t.Errorf("want: Host waf.example.com:%v\n got: %v", port, hosts)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"golang.org/x/net/html"
+)
+
+//selectorToken matches the tag, id, class and attribute parts of a compound CSS selector
+var selectorToken = regexp.MustCompile(`^(?:([a-zA-Z][\w-]*|\*)|#([\w-]+)|\.([\w-]+)|\[([\w-]+)(?:=(?:"([^"]*)"|'([^']*)'|([^\]]*)))?\])`)
+
+//selectorPart is a compound selector, such as input.hidden[name=csrf]
+type selectorPart struct {
+	tag     string
+	id      string
+	classes []string
+	attrs   []*selectorAttr
+}
+
+//selectorAttr is an attribute selector, matching the value of the attribute when hasValue is set
+//and its presence otherwise
+type selectorAttr struct {
+	name     string
+	value    string
+	hasValue bool
+}
+
+//extract returns the value described by e from the response and its body
+func extract(e *config.Extract, resp *http.Response, body []byte) (string, error) {
+	switch {
+	case e.Header != "":
+		value := resp.Header.Get(e.Header)
+		if value == "" {
+			return "", fmt.Errorf("header %v not found in response", e.Header)
+		}
+		return value, nil
+	case e.Pattern != nil:
+		match := e.Pattern.FindSubmatch(body)
+		if match == nil {
+			return "", fmt.Errorf("regex %v does not match response", e.Regex)
+		}
+		if len(match) > 1 {
+			return string(match[1]), nil
+		}
+		return string(match[0]), nil
+	case e.JSONPath != "":
+		return jsonPathValue(body, e.JSONPath)
+	case e.Selector != "":
+		return selectorValue(body, e.Selector, e.Attribute)
+	}
+	return "", fmt.Errorf("nothing to extract")
+}
+
+//jsonPathValue returns the value at the dotted path of a JSON document, where numbers index arrays.
+//Strings are returned as they are and other values as JSON.
+func jsonPathValue(body []byte, path string) (string, error) {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return "", fmt.Errorf("response is not JSON: %v", err)
+	}
+	if path != "" {
+		for _, key := range strings.Split(path, ".") {
+			switch v := value.(type) {
+			case map[string]interface{}:
+				field, ok := v[key]
+				if !ok {
+					return "", fmt.Errorf("json path %v not found in response", path)
+				}
+				value = field
+			case []interface{}:
+				i, err := strconv.Atoi(key)
+				if err != nil || i < 0 || i >= len(v) {
+					return "", fmt.Errorf("json path %v not found in response", path)
+				}
+				value = v[i]
+			default:
+				return "", fmt.Errorf("json path %v not found in response", path)
+			}
+		}
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	raw, err := json.Marshal(value)
+	return string(raw), err
+}
+
+//selectorValue returns the attribute of the first element of the HTML document matching the selector.
+//Without an attribute it is the value of inputs, the content of meta tags or the text of other elements.
+func selectorValue(body []byte, selector string, attribute string) (string, error) {
+	parts, err := parseSelector(selector)
+	if err != nil {
+		return "", err
+	}
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("response is not HTML: %v", err)
+	}
+	node := findElement(doc, parts)
+	if node == nil {
+		return "", fmt.Errorf("selector %v does not match response", selector)
+	}
+	if attribute == "" {
+		switch node.Data {
+		case "input", "option", "button":
+			attribute = "value"
+		case "meta":
+			attribute = "content"
+		}
+	}
+	if attribute == "" {
+		return strings.TrimSpace(nodeText(node)), nil
+	}
+	for _, a := range node.Attr {
+		if a.Key == attribute {
+			return a.Val, nil
+		}
+	}
+	return "", fmt.Errorf("element matching %v has no attribute %v", selector, attribute)
+}
+
+//parseSelector parses a selector of compound selectors separated by descendant combinators
+func parseSelector(selector string) ([]*selectorPart, error) {
+	var parts []*selectorPart
+	for _, compound := range strings.Fields(selector) {
+		part := &selectorPart{}
+		for rest := compound; rest != ""; {
+			m := selectorToken.FindStringSubmatch(rest)
+			if m == nil {
+				return nil, fmt.Errorf("unsupported selector %q", selector)
+			}
+			rest = rest[len(m[0]):]
+			switch {
+			case m[1] != "":
+				part.tag = strings.ToLower(m[1])
+			case m[2] != "":
+				part.id = m[2]
+			case m[3] != "":
+				part.classes = append(part.classes, m[3])
+			case m[4] != "":
+				part.attrs = append(part.attrs, &selectorAttr{
+					name:     strings.ToLower(m[4]),
+					value:    m[5] + m[6] + m[7],
+					hasValue: strings.Contains(m[0], "="),
+				})
+			}
+		}
+		parts = append(parts, part)
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("empty selector")
+	}
+	return parts, nil
+}
+
+//matches returns true if the element matches the compound selector
+func (p *selectorPart) matches(n *html.Node) bool {
+	if n.Type != html.ElementNode || (p.tag != "" && p.tag != "*" && p.tag != n.Data) {
+		return false
+	}
+	attrs := make(map[string]string)
+	for _, a := range n.Attr {
+		attrs[a.Key] = a.Val
+	}
+	if p.id != "" && attrs["id"] != p.id {
+		return false
+	}
+	for _, c := range p.classes {
+		if !stringContains(strings.Fields(attrs["class"]), c) {
+			return false
+		}
+	}
+	for _, a := range p.attrs {
+		value, ok := attrs[a.name]
+		if !ok || (a.hasValue && value != a.value) {
+			return false
+		}
+	}
+	return true
+}
+
+//findElement returns the first element in document order matching the last part of the selector
+//with ancestors matching the parts before it
+func findElement(n *html.Node, parts []*selectorPart) *html.Node {
+	if parts[len(parts)-1].matches(n) {
+		i := len(parts) - 2
+		for a := n.Parent; a != nil && i >= 0; a = a.Parent {
+			if parts[i].matches(a) {
+				i--
+			}
+		}
+		if i < 0 {
+			return n
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := findElement(c, parts); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+//nodeText returns the text of the node and its descendants
+func nodeText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var text strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		text.WriteString(nodeText(c))
+	}
+	return text.String()
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+func TestExtract(t *testing.T) {
+	body := []byte(`{"data": {"token": "abc", "items": [{"id": 7}]}} <input name="csrf" value="xyz">`)
+	jsonBody := []byte(`{"data": {"token": "abc", "items": [{"id": 7}]}}`)
+	htmlBody := []byte(`<html><head><meta name="nonce" content="n0nce"></head><body>
+<form id="search"><input name="csrf" value="wrong"></form>
+<form id="login" action="/login"><p><input type="hidden" name="csrf" value="right"></p></form>
+<div class="hidden token"> t0ken </div></body></html>`)
+	resp := &http.Response{Header: http.Header{"X-Token": {"def"}}}
+	tests := []struct {
+		name    string
+		extract *config.Extract
+		body    []byte
+		want    string
+		err     bool
+	}{
+		{
+			name:    "regexGroup",
+			extract: &config.Extract{Regex: `value="([^"]*)"`, Pattern: regexp.MustCompile(`value="([^"]*)"`)},
+			body:    body,
+			want:    "xyz",
+		},
+		{
+			name:    "regexMatch",
+			extract: &config.Extract{Regex: `x.z`, Pattern: regexp.MustCompile(`x.z`)},
+			body:    body,
+			want:    "xyz",
+		},
+		{
+			name:    "regexMissing",
+			extract: &config.Extract{Regex: `nope`, Pattern: regexp.MustCompile(`nope`)},
+			body:    body,
+			err:     true,
+		},
+		{
+			name:    "jsonString",
+			extract: &config.Extract{JSONPath: "data.token"},
+			body:    jsonBody,
+			want:    "abc",
+		},
+		{
+			name:    "jsonArray",
+			extract: &config.Extract{JSONPath: "data.items.0.id"},
+			body:    jsonBody,
+			want:    "7",
+		},
+		{
+			name:    "jsonMissing",
+			extract: &config.Extract{JSONPath: "data.items.1"},
+			body:    jsonBody,
+			err:     true,
+		},
+		{
+			name:    "header",
+			extract: &config.Extract{Header: "X-Token"},
+			want:    "def",
+		},
+		{
+			name:    "selectorInput",
+			extract: &config.Extract{Selector: `form#login input[name="csrf"]`},
+			body:    htmlBody,
+			want:    "right",
+		},
+		{
+			name:    "selectorMeta",
+			extract: &config.Extract{Selector: "meta[name=nonce]"},
+			body:    htmlBody,
+			want:    "n0nce",
+		},
+		{
+			name:    "selectorText",
+			extract: &config.Extract{Selector: "div.token.hidden"},
+			body:    htmlBody,
+			want:    "t0ken",
+		},
+		{
+			name:    "selectorAttribute",
+			extract: &config.Extract{Selector: "form#login", Attribute: "action"},
+			body:    htmlBody,
+			want:    "/login",
+		},
+		{
+			name:    "selectorMissing",
+			extract: &config.Extract{Selector: "form#signup input"},
+			body:    htmlBody,
+			err:     true,
+		},
+		{
+			name:    "selectorUnsupported",
+			extract: &config.Extract{Selector: "form > input"},
+			body:    htmlBody,
+			err:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extract(tt.extract, resp, tt.body)
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("want: %v\n got: %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package app
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+//grpcMessage encodes a request message holding the payload in the string field at the key of the
+//location, or in every top level string field when no key is set. The fields are written directly
+//in the wire format so payloads that are not valid UTF-8 are still sent.
+func grpcMessage(location *config.TestLocation, payload string) ([]byte, error) {
+	if location.Message == nil {
+		return nil, fmt.Errorf("no message resolved for rpc %v", location.RPC)
+	}
+	if location.Key == "" {
+		var message []byte
+		fields := location.Message.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			if fields.Get(i).Kind() == protoreflect.StringKind {
+				message = protowire.AppendTag(message, fields.Get(i).Number(), protowire.BytesType)
+				message = protowire.AppendString(message, payload)
+			}
+		}
+		return message, nil
+	}
+	path, err := config.StringField(location.Message, location.Key)
+	if err != nil {
+		return nil, err
+	}
+	//wrap the string field in each of its parent messages from the inside out
+	message := []byte(payload)
+	for i := len(path) - 1; i >= 0; i-- {
+		field := protowire.AppendTag(nil, path[i].Number(), protowire.BytesType)
+		message = protowire.AppendBytes(field, message)
+	}
+	return message, nil
+}
+
+//grpcFrame prefixes the message with the uncompressed flag and the message length
+func grpcFrame(message []byte) []byte {
+	frame := make([]byte, 5, 5+len(message))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(message)))
+	return append(frame, message...)
+}
+
+//sendGRPC transacts the gRPC request and reads the whole response so the trailers are available.
+//gRPC-Web sends the trailers as the last frame of the body, which are moved to the response trailers.
+func (t *Target) sendGRPC(testRequest *TestRequest) (*http.Response, error) {
+	resp, err := t.Client.Do(testRequest.Request)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if resp.Trailer == nil {
+		resp.Trailer = http.Header{}
+	}
+	if testRequest.locationType() == "grpc_web" && strings.HasPrefix(resp.Header.Get("Content-Type"), "application/grpc-web") {
+		body = grpcWebTrailers(body, resp.Trailer)
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+//grpcWebTrailers parses the frames of a gRPC-Web response body, adding the headers of trailer frames
+//to trailers and returning the body without them
+func grpcWebTrailers(body []byte, trailers http.Header) []byte {
+	var data []byte
+	for len(body) >= 5 {
+		flag := body[0]
+		length := int(binary.BigEndian.Uint32(body[1:5]))
+		if length > len(body)-5 {
+			break
+		}
+		frame := body[:5+length]
+		body = body[5+length:]
+		if flag&0x80 == 0 {
+			data = append(data, frame...)
+			continue
+		}
+		for _, line := range strings.Split(string(frame[5:]), "\r\n") {
+			if i := strings.Index(line, ":"); i > 0 {
+				trailers.Add(textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(line[:i])), strings.TrimSpace(line[i+1:]))
+			}
+		}
+	}
+	return append(data, body...)
+}
+
+//grpcStatus returns the grpc-status of the response, which is sent in the trailers or in the
+//headers of a trailers-only response, or -1 when there is none
+func grpcStatus(resp *http.Response) int {
+	value := resp.Trailer.Get("Grpc-Status")
+	if value == "" {
+		value = resp.Header.Get("Grpc-Status")
+	}
+	status, err := strconv.Atoi(value)
+	if err != nil {
+		return -1
+	}
+	return status
+}
+
+//grpcBlocked returns true if the response matches the HTTP block condition or carries the grpc-status of
+//the block condition. Block condition headers may be found in the headers or the trailers.
+func grpcBlocked(testRequest *TestRequest) bool {
+	resp := testRequest.Response
+	blockCon := testRequest.BlockCon
+	if blockCon.GRPCStatus != 0 && grpcStatus(resp) == blockCon.GRPCStatus {
+		return true
+	}
+	if resp.StatusCode != blockCon.Code {
+		return false
+	}
+	return len(blockCon.Headers) == 0 || headerCheck(blockCon.Headers, resp) || headerCheck(blockCon.Headers, &http.Response{Header: resp.Trailer})
+}
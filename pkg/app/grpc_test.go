@@ -0,0 +1,173 @@
+package app
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+//helloRequest returns the descriptor of a message with a string field, a nested message
+//holding a string field, and a number field
+func helloRequest(t *testing.T) protoreflect.MessageDescriptor {
+	field := func(name string, number int32, kind descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   kind.Enum(),
+		}
+	}
+	user := field("user", 2, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+	user.TypeName = proto.String(".test.User")
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("greeter.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name:  proto.String("User"),
+				Field: []*descriptorpb.FieldDescriptorProto{field("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING)},
+			},
+			{
+				Name: proto.String("HelloRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("greeting", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					user,
+					field("count", 3, descriptorpb.FieldDescriptorProto_TYPE_INT32),
+					field("note", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file.Messages().ByName("HelloRequest")
+}
+
+func TestGRPCMessage(t *testing.T) {
+	message := helloRequest(t)
+	tests := []struct {
+		name string
+		key  string
+		want []byte
+	}{
+		{
+			name: "allStrings",
+			want: []byte("\x0a\x04bar!\x22\x04bar!"),
+		},
+		{
+			name: "nestedField",
+			key:  "user.name",
+			want: []byte("\x12\x06\x0a\x04bar!"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location := &config.TestLocation{Location: "grpc", Key: tt.key, RPC: "test.Greeter/SayHello", Message: message}
+			got, err := grpcMessage(location, "bar!")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok := cmp.Equal(tt.want, got); !ok {
+				diff := cmp.Diff(tt.want, got)
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGRPCWebTrailers(t *testing.T) {
+	body := append(grpcFrame([]byte("data")), 0x80, 0, 0, 0, 34)
+	body = append(body, "grpc-status: 7\r\ngrpc-message: no\r\n"...)
+	trailers := http.Header{}
+	got := grpcWebTrailers(body, trailers)
+	if !bytes.Equal(got, grpcFrame([]byte("data"))) {
+		t.Errorf("want: %q\n got: %q", grpcFrame([]byte("data")), got)
+	}
+	want := http.Header{"Grpc-Status": {"7"}, "Grpc-Message": {"no"}}
+	if ok := cmp.Equal(want, trailers); !ok {
+		diff := cmp.Diff(want, trailers)
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSendGRPC(t *testing.T) {
+	message := helloRequest(t)
+	//a server that answers with a permission denied grpc-status when the message contains the payload
+	server := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		status := "0"
+		if bytes.Contains(body, []byte("bar!")) {
+			status = "7"
+		}
+		if r.Header.Get("Content-Type") == "application/grpc-web+proto" {
+			w.Header().Set("Content-Type", "application/grpc-web+proto")
+			trailer := "grpc-status: " + status + "\r\n"
+			w.Write(append([]byte{0x80, 0, 0, 0, byte(len(trailer))}, trailer...))
+			return
+		}
+		w.Header().Set("Content-Type", "application/grpc")
+		w.Header().Set("Trailer", "Grpc-Status")
+		w.Write(grpcFrame(nil))
+		w.Header().Set("Grpc-Status", status)
+	}), &http2.Server{}))
+	defer server.Close()
+	tests := []struct {
+		name     string
+		location string
+		version  string
+		payload  string
+		want     string
+	}{
+		{name: "grpcBlocked", location: "grpc", version: "h2c", payload: "bar!", want: stringPass},
+		{name: "grpcAllowed", location: "grpc", version: "h2c", payload: "foo", want: stringFN},
+		{name: "grpcWebBlocked", location: "grpc_web", version: "1.1", payload: "bar!", want: stringPass},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSet := &config.TestSet{Name: "test", URI: server.URL + "/", HTTPVersion: tt.version}
+			target, _ := NewTarget(testSet)
+			a := &Application{TestRun: &config.TestRun{}, Targets: map[string]*Target{"test": target}}
+			location := &config.TestLocation{Location: tt.location, RPC: "test.Greeter/SayHello", Message: message}
+			testRequest := &TestRequest{
+				SetName:      "test",
+				Payload:      tt.payload,
+				TestType:     stringFN,
+				TestLocation: location,
+				BlockCon:     &config.Condition{Code: 403, GRPCStatus: 7},
+			}
+			if err := a.buildRequest(testRequest, location, testSet); err != nil {
+				t.Fatal(err)
+			}
+			if testRequest.Request.URL.Path != "/test.Greeter/SayHello" {
+				t.Errorf("want: /test.Greeter/SayHello\n got: %v", testRequest.Request.URL.Path)
+			}
+			resp, err := a.send(testRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			testRequest.Response = resp
+			got, err := getOutcome(testRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("want: %v\n got: %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+//h2SkipHeaders are connection-specific headers that have no meaning in HTTP/2 (RFC 7540 section 8.1.2.2)
+var h2SkipHeaders = map[string]bool{
+	"connection":        true,
+	"host":              true,
+	"keep-alive":        true,
+	"proxy-connection":  true,
+	"transfer-encoding": true,
+	"upgrade":           true,
+}
+
+//h2Fields builds the HTTP/2 header fields of a test request. Unlike the HTTP/2 transport, which
+//lowercases and validates every field, the fields are written as-is so the payload can be placed in a
+//pseudo-header or in a header name with uppercase characters, both of which are malformed in HTTP/2.
+func h2Fields(testRequest *TestRequest, bodyLength int) []hpack.HeaderField {
+	req := testRequest.Request
+	location := testRequest.TestLocation
+	pseudo := []hpack.HeaderField{
+		{Name: ":method", Value: req.Method},
+		{Name: ":scheme", Value: req.URL.Scheme},
+		{Name: ":authority", Value: req.URL.Host},
+		{Name: ":path", Value: req.URL.RequestURI()},
+	}
+	if strings.ToLower(location.Location) == "h2_pseudoheader" {
+		value := testRequest.Payload
+		if location.Key == ":path" {
+			value = "/" + value
+		}
+		found := false
+		for i := range pseudo {
+			if pseudo[i].Name == location.Key {
+				pseudo[i].Value = value
+				found = true
+			}
+		}
+		//unknown pseudo-headers are added so the WAF sees them
+		if !found {
+			pseudo = append(pseudo, hpack.HeaderField{Name: location.Key, Value: value})
+		}
+	}
+	var keys []string
+	for k := range req.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fields := pseudo
+	for _, k := range keys {
+		name := strings.ToLower(k)
+		if h2SkipHeaders[name] {
+			continue
+		}
+		if strings.ToLower(location.Location) == "h2_header" && k == location.Key {
+			name = k
+		}
+		for _, v := range req.Header[k] {
+			fields = append(fields, hpack.HeaderField{Name: name, Value: v})
+		}
+	}
+	if bodyLength > 0 {
+		fields = append(fields, hpack.HeaderField{Name: "content-length", Value: strconv.Itoa(bodyLength)})
+	}
+	return fields
+}
+
+//sendH2 sends the test request on a new HTTP/2 connection using a single stream, writing the
+//header fields directly with the framer and reading frames until the response stream ends
+func (t *Target) sendH2(testRequest *TestRequest) (*http.Response, error) {
+	if !t.http2() {
+		return nil, fmt.Errorf("location %v requires a test set using HTTP/2", testRequest.Location)
+	}
+	req := testRequest.Request
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	fields := h2Fields(testRequest, len(body))
+	//keep a readable copy of the request for the report
+	var dump bytes.Buffer
+	for _, f := range fields {
+		fmt.Fprintf(&dump, "%s: %s\r\n", f.Name, f.Value)
+	}
+	dump.WriteString("\r\n")
+	dump.Write(body)
+	testRequest.RawRequest = dump.Bytes()
+
+	conn, err := t.dial(req, "h2")
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(t.readTimeout()))
+	if _, err := conn.Write([]byte(http2.ClientPreface)); err != nil {
+		return nil, err
+	}
+	framer := http2.NewFramer(conn, conn)
+	framer.ReadMetaHeaders = hpack.NewDecoder(4096, nil)
+	if err := framer.WriteSettings(); err != nil {
+		return nil, err
+	}
+	var block bytes.Buffer
+	encoder := hpack.NewEncoder(&block)
+	for _, f := range fields {
+		if err := encoder.WriteField(f); err != nil {
+			return nil, err
+		}
+	}
+	err = framer.WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      1,
+		BlockFragment: block.Bytes(),
+		EndStream:     len(body) == 0,
+		EndHeaders:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > 0 {
+		if err := framer.WriteData(1, true, body); err != nil {
+			return nil, err
+		}
+	}
+	return readH2Response(framer, req)
+}
+
+//readH2Response reads frames until the response on stream 1 has ended, answering
+//settings and pings and granting flow control credit for received data
+func readH2Response(framer *http2.Framer, req *http.Request) (*http.Response, error) {
+	resp := &http.Response{
+		Proto:      "HTTP/2.0",
+		ProtoMajor: 2,
+		Header:     http.Header{},
+		Trailer:    http.Header{},
+		Request:    req,
+	}
+	var data bytes.Buffer
+	headersDone := false
+	for {
+		frame, err := framer.ReadFrame()
+		if err != nil {
+			return nil, err
+		}
+		ended := false
+		switch f := frame.(type) {
+		case *http2.SettingsFrame:
+			if !f.IsAck() {
+				if err := framer.WriteSettingsAck(); err != nil {
+					return nil, err
+				}
+			}
+		case *http2.PingFrame:
+			if !f.IsAck() {
+				if err := framer.WritePing(true, f.Data); err != nil {
+					return nil, err
+				}
+			}
+		case *http2.MetaHeadersFrame:
+			if f.StreamID != 1 {
+				continue
+			}
+			for _, hf := range f.Fields {
+				if hf.Name == ":status" {
+					code, err := strconv.Atoi(hf.Value)
+					if err != nil {
+						return nil, fmt.Errorf("invalid status %q", hf.Value)
+					}
+					resp.StatusCode = code
+					resp.Status = hf.Value + " " + http.StatusText(code)
+					continue
+				}
+				//headers after the first block are trailers
+				if headersDone {
+					resp.Trailer.Add(http.CanonicalHeaderKey(hf.Name), hf.Value)
+				} else {
+					resp.Header.Add(http.CanonicalHeaderKey(hf.Name), hf.Value)
+				}
+			}
+			headersDone = true
+			ended = f.StreamEnded()
+		case *http2.DataFrame:
+			if f.StreamID != 1 {
+				continue
+			}
+			data.Write(f.Data())
+			if n := uint32(len(f.Data())); n > 0 {
+				framer.WriteWindowUpdate(0, n)
+				framer.WriteWindowUpdate(1, n)
+			}
+			ended = f.StreamEnded()
+		case *http2.RSTStreamFrame:
+			return nil, fmt.Errorf("stream reset by server: %v", f.ErrCode)
+		case *http2.GoAwayFrame:
+			return nil, fmt.Errorf("connection closed by server: %v", f.ErrCode)
+		}
+		if ended {
+			break
+		}
+	}
+	resp.ContentLength = int64(data.Len())
+	resp.Body = ioutil.NopCloser(&data)
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"golang.org/x/net/http2/hpack"
+)
+
+func TestH2Fields(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "http://testhost/foo", nil)
+	req.Header["X-Foo"] = []string{"bar!"}
+	req.Header.Set("Connection", "close")
+	req.Header.Set("Lorem", "Ipsum")
+	tests := []struct {
+		name     string
+		location *config.TestLocation
+		want     []hpack.HeaderField
+	}{
+		{
+			name:     "uppercaseHeader",
+			location: &config.TestLocation{Location: "h2_header", Key: "X-Foo"},
+			want: []hpack.HeaderField{
+				{Name: ":method", Value: "GET"},
+				{Name: ":scheme", Value: "http"},
+				{Name: ":authority", Value: "testhost"},
+				{Name: ":path", Value: "/foo"},
+				{Name: "lorem", Value: "Ipsum"},
+				{Name: "X-Foo", Value: "bar!"},
+			},
+		},
+		{
+			name:     "pathPseudoHeader",
+			location: &config.TestLocation{Location: "h2_pseudoheader", Key: ":path"},
+			want: []hpack.HeaderField{
+				{Name: ":method", Value: "GET"},
+				{Name: ":scheme", Value: "http"},
+				{Name: ":authority", Value: "testhost"},
+				{Name: ":path", Value: "/bar!"},
+				{Name: "lorem", Value: "Ipsum"},
+				{Name: "x-foo", Value: "bar!"},
+			},
+		},
+		{
+			name:     "unknownPseudoHeader",
+			location: &config.TestLocation{Location: "h2_pseudoheader", Key: ":foo"},
+			want: []hpack.HeaderField{
+				{Name: ":method", Value: "GET"},
+				{Name: ":scheme", Value: "http"},
+				{Name: ":authority", Value: "testhost"},
+				{Name: ":path", Value: "/foo"},
+				{Name: ":foo", Value: "bar!"},
+				{Name: "lorem", Value: "Ipsum"},
+				{Name: "x-foo", Value: "bar!"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testRequest := &TestRequest{Payload: "bar!", Request: req, TestLocation: tt.location}
+			got := h2Fields(testRequest, 0)
+			if ok := cmp.Equal(tt.want, got); !ok {
+				diff := cmp.Diff(tt.want, got)
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSendH2(t *testing.T) {
+	server := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "bar!") {
+			w.WriteHeader(http.StatusNotAcceptable)
+		}
+		w.Write([]byte("body"))
+	}), &http2.Server{}))
+	defer server.Close()
+	target := &Target{Set: &config.TestSet{HTTPVersion: "h2c"}}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	testRequest := &TestRequest{
+		Payload:      "bar!",
+		Request:      req,
+		TestLocation: &config.TestLocation{Location: "h2_pseudoheader", Key: ":path"},
+	}
+	resp, err := target.sendH2(testRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusNotAcceptable {
+		t.Errorf("want: %v\n got: %v", http.StatusNotAcceptable, resp.StatusCode)
+	}
+
+	//uppercase header names are malformed and the stream is reset
+	req, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header["X-Foo"] = []string{"bar!"}
+	testRequest = &TestRequest{
+		Payload:      "bar!",
+		Request:      req,
+		TestLocation: &config.TestLocation{Location: "h2_header", Key: "X-Foo"},
+	}
+	if _, err := target.sendH2(testRequest); err == nil || !strings.Contains(err.Error(), "stream reset") {
+		t.Errorf("want stream reset error, got: %v", err)
+	}
+
+	//HTTP/2 locations need a test set speaking HTTP/2
+	if _, err := (&Target{}).sendH2(testRequest); err == nil {
+		t.Errorf("no expected error")
+	}
+}
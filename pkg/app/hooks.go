@@ -0,0 +1,174 @@
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/signalsciences/waf-testing-framework/pkg/results"
+)
+
+//Hook receives the events of a run as they happen, such as to feed a dashboard or a log pipeline.
+//The events of the tests are sent from the workers, so the methods must be safe for concurrent use
+//and return quickly.
+type Hook interface {
+	//OnRunStart is called before the first test is queued
+	OnRunStart(testRun *config.TestRun)
+	//OnTestQueued is called as a test is queued. A test queued as the run is interrupted may never
+	//be sent, and is counted with the tests not sent.
+	OnTestQueued(testRequest *TestRequest)
+	//OnResult is called once the result of a test, passed or not, is saved
+	OnResult(testResult *results.TestResult)
+	//OnRunEnd is called with the processed results once the run is done
+	OnRunEnd(results *results.Results)
+}
+
+//runStart calls the OnRunStart hooks
+func (a *Application) runStart() {
+	for _, hook := range a.Hooks {
+		hook.OnRunStart(a.TestRun)
+	}
+}
+
+//testQueued calls the OnTestQueued hooks
+func (a *Application) testQueued(testRequest *TestRequest) {
+	for _, hook := range a.Hooks {
+		hook.OnTestQueued(testRequest)
+	}
+}
+
+//result calls the OnResult hooks
+func (a *Application) result(testResult *results.TestResult) {
+	for _, hook := range a.Hooks {
+		hook.OnResult(testResult)
+	}
+}
+
+//runEnd calls the OnRunEnd hooks
+func (a *Application) runEnd() {
+	for _, hook := range a.Hooks {
+		hook.OnRunEnd(a.Results)
+	}
+}
+
+//hookEvent is a line written by an NDJSONHook
+type hookEvent struct {
+	Event      string
+	Time       time.Time
+	Sets       []string                      `json:",omitempty"`
+	Set        string                        `json:",omitempty"`
+	File       string                        `json:",omitempty"`
+	Line       int                           `json:",omitempty"`
+	Payload    string                        `json:",omitempty"`
+	Location   string                        `json:",omitempty"`
+	TestType   string                        `json:",omitempty"`
+	Outcome    string                        `json:",omitempty"`
+	Attempts   int                           `json:",omitempty"`
+	Request    string                        `json:",omitempty"`
+	Response   string                        `json:",omitempty"`
+	Incomplete bool                          `json:",omitempty"`
+	SetCounts  map[string]*results.SetCounts `json:",omitempty"`
+}
+
+//NDJSONHook writes the events of a run as lines of JSON, the run_start, test_queued, result and
+//run_end events
+type NDJSONHook struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+	closer  io.Closer
+	err     error
+}
+
+//NewNDJSONHook returns a hook writing the events of a run to w, such as os.Stdout
+func NewNDJSONHook(w io.Writer) *NDJSONHook {
+	return &NDJSONHook{encoder: json.NewEncoder(w)}
+}
+
+//NewNDJSONFileHook returns a hook writing the events of a run to the file at the path, replacing
+//the file if it exists
+func NewNDJSONFileHook(path string) (*NDJSONHook, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	hook := NewNDJSONHook(f)
+	hook.closer = f
+	return hook, nil
+}
+
+//Close closes the file of the hook, returning the first error met writing the events
+func (h *NDJSONHook) Close() error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.closer != nil {
+		if err := h.closer.Close(); err != nil && h.err == nil {
+			h.err = err
+		}
+		h.closer = nil
+	}
+	return h.err
+}
+
+//write writes an event, keeping the first error met
+func (h *NDJSONHook) write(event *hookEvent) {
+	event.Time = time.Now()
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if err := h.encoder.Encode(event); err != nil && h.err == nil {
+		h.err = err
+	}
+}
+
+//OnRunStart writes a run_start event with the names of the test sets
+func (h *NDJSONHook) OnRunStart(testRun *config.TestRun) {
+	event := &hookEvent{Event: "run_start"}
+	for _, testSet := range testRun.TestSets {
+		event.Sets = append(event.Sets, testSet.Name)
+	}
+	h.write(event)
+}
+
+//OnTestQueued writes a test_queued event
+func (h *NDJSONHook) OnTestQueued(testRequest *TestRequest) {
+	h.write(&hookEvent{
+		Event:    "test_queued",
+		Set:      testRequest.SetName,
+		File:     testRequest.FileName,
+		Line:     testRequest.Line,
+		Location: testRequest.Location,
+		TestType: testRequest.TestType,
+	})
+}
+
+//OnResult writes a result event, with the request and the response of tests that did not pass
+func (h *NDJSONHook) OnResult(testResult *results.TestResult) {
+	h.write(&hookEvent{
+		Event:    "result",
+		Set:      testResult.SetName,
+		File:     testResult.FileName,
+		Line:     testResult.Line,
+		Payload:  testResult.Payload,
+		Location: testResult.Location,
+		TestType: testResult.TestType,
+		Outcome:  testResult.Outcome,
+		Attempts: testResult.Attempts,
+		Request:  testResult.Request,
+		Response: testResult.Response,
+	})
+}
+
+//OnRunEnd writes a run_end event with the counts of every test set
+func (h *NDJSONHook) OnRunEnd(results *results.Results) {
+	h.write(&hookEvent{
+		Event:      "run_end",
+		Incomplete: results.Incomplete,
+		SetCounts:  results.SetCounts,
+	})
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestNDJSONHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotAcceptable)
+	}))
+	defer server.Close()
+	var buf bytes.Buffer
+	runner, err := New(runnerTestRun(server.URL+"/"), WithHooks(NewNDJSONHook(&buf)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runner.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	fileName := filepath.FromSlash("false_positives/fp.txt")
+	want := []*hookEvent{
+		{Event: "run_start", Sets: []string{"waf"}},
+		{Event: "test_queued", Set: "waf", File: fileName, Line: 1, Location: "header", TestType: stringFP},
+		{Event: "result", Set: "waf", File: fileName, Line: 1, Payload: "LOCK AND KEY", Location: "header", TestType: stringFP, Outcome: stringFP, Attempts: 1},
+		{Event: "run_end"},
+	}
+	var got []*hookEvent
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		event := &hookEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			t.Fatalf("invalid event %q: %v", scanner.Text(), err)
+		}
+		if event.Time.IsZero() {
+			t.Errorf("no time in event %q", scanner.Text())
+		}
+		got = append(got, event)
+	}
+	if len(got) == len(want) {
+		if got[2].Request == "" || got[2].Response == "" {
+			t.Errorf("want: request and response of the false positive\n got: %+v", got[2])
+		}
+		if counts := got[3].SetCounts["waf"]; counts == nil || counts.FpCount != 1 || counts.TotalCount != 1 {
+			t.Errorf("want: counts of the run\n got: %+v", counts)
+		}
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(hookEvent{}, "Time", "Request", "Response", "SetCounts")); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNDJSONFileHook(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "events", "run.ndjson")
+	hook, err := NewNDJSONFileHook(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hook.OnRunStart(runnerTestRun("http://localhost/"))
+	if err := hook.Close(); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := &hookEvent{}
+	if err := json.Unmarshal(out, event); err != nil || event.Event != "run_start" {
+		t.Errorf("want: run_start event\n got: %q, %v", out, err)
+	}
+}
@@ -38,78 +38,51 @@ func reList(re *regexp.Regexp, Payload string) ([][][]byte, []int) {
 //It returns a boolean indicating if invalid characters were found, the invalid charcters, and where
 //in the payload the invalid charcters were found
 func checkInvalidChars(Payload string, TestType string) (bool, string, []int) {
-	invalidString := "Invalid characters in payload "
-	var invalidCharsResult []string
 	switch TestType {
 	//RFC 7230
 	case "header":
-		matched, _ := regexp.Match(`[^\x20-\x7E]+`, []byte(Payload))
-		if matched {
-			payloadRegex, _ := regexp.Compile(`[^\x20-\x7E]+`)
-			invalidChars, invalidIndex := reList(payloadRegex, Payload)
-			//append all chars of [][][] byte array to single string
-			for _, line := range invalidChars {
-				for _, match := range line { // match is a type of []byte
-					if !stringContains(invalidCharsResult, string(match)) {
-						invalidCharsResult = append(invalidCharsResult, string(match))
-					}
-				}
-			}
-			invalidString += "based on RFC 7230: "
-			for _, char := range invalidCharsResult {
-				invalidString += "'" + char + "', "
-			}
-			invalidString = strings.TrimSuffix(invalidString, ", ")
-			return true, invalidString, invalidIndex
-		}
-		return false, "", nil
+		return matchInvalidChars(`[^\x20-\x7E]+`, Payload, "RFC 7230")
 	//RFC 3986 & RFC 1738
 	//valid characters are a-z A-Z 0-9 . - _ ~ ! $ & ' ( ) * + , ; = : @ %
 	case "path", "queryarg":
-		matched, _ := regexp.Match(`[^!\x24-\x7E]+`, []byte(Payload))
-		if matched {
-			payloadRegex, _ := regexp.Compile(`[^!\x24-\x7E]+`)
-			invalidChars, invalidIndex := reList(payloadRegex, Payload)
-			//append all chars of [][][] byte array to single string
-			for _, line := range invalidChars {
-				for _, match := range line { // match is a type of []byte
-					if !stringContains(invalidCharsResult, string(match)) {
-						invalidCharsResult = append(invalidCharsResult, string(match))
-					}
-				}
-			}
-			invalidString += "based on RFC 3986 and 1738: "
-			for _, char := range invalidCharsResult {
-				invalidString += "'" + char + "', "
-			}
-			invalidString = strings.TrimSuffix(invalidString, ", ")
-			return true, invalidString, invalidIndex
-		}
-		return false, "", nil
+		return matchInvalidChars(`[^!\x24-\x7E]+`, Payload, "RFC 3986 and 1738")
 	// RFC 2109
 	//alphanum + !#$%&'()*+-./:<=>?@[]^_`{|}~
 	case "cookie":
-		matched, _ := regexp.Match(`[^!\x23-\x2B\x2D-\x3A\x3C-\x5B\x5D-\x7E]`, []byte(Payload))
-		if matched {
-			payloadRegex, _ := regexp.Compile(`[^!\x23-\x2B\x2D-\x3A\x3C-\x5B\x5D-\x7E]`)
-			invalidChars, invalidIndex := reList(payloadRegex, Payload)
-			//append all chars of [][][] byte array to single string
-			for _, line := range invalidChars {
-				for _, match := range line { // match is a type of []byte
-					if !stringContains(invalidCharsResult, string(match)) {
-						invalidCharsResult = append(invalidCharsResult, string(match))
-					}
-				}
-			}
-			invalidString += "based on RFC 2109: "
-			for _, char := range invalidCharsResult {
-				invalidString += "'" + char + "', "
-			}
-			invalidString = strings.TrimSuffix(invalidString, ", ")
-			return true, invalidString, invalidIndex
-		}
-		return false, "", nil
+		return matchInvalidChars(`[^!\x23-\x2B\x2D-\x3A\x3C-\x5B\x5D-\x7E]`, Payload, "RFC 2109")
+	//RFC 7230 section 3.2.6 and RFC 6265 section 4.1.1, header and cookie names must be tokens
+	//alphanum + !#$%&'*+-.^_`|~
+	case "header_name", "cookie_name":
+		return matchInvalidChars("[^!#$%&'*+\\-.^_`|~0-9A-Za-z]+", Payload, "RFC 7230 and 6265")
+	//RFC 3986 & RFC 1738 without the & and = delimiters which would end the parameter name
+	case "queryarg_name":
+		return matchInvalidChars(`[^!\x24\x25\x27-\x3C\x3E-\x7E]+`, Payload, "RFC 3986 and 1738")
 	default:
 		return false, "", nil
 	}
 }
+
+//matchInvalidChars looks for characters matching the invalid character expression in the payload and
+//returns the unique invalid characters found along with their position in the payload
+func matchInvalidChars(expr string, Payload string, rfc string) (bool, string, []int) {
+	payloadRegex := regexp.MustCompile(expr)
+	if !payloadRegex.MatchString(Payload) {
+		return false, "", nil
+	}
+	var invalidCharsResult []string
+	invalidChars, invalidIndex := reList(payloadRegex, Payload)
+	//append all chars of [][][] byte array to single string
+	for _, line := range invalidChars {
+		for _, match := range line { // match is a type of []byte
+			if !stringContains(invalidCharsResult, string(match)) {
+				invalidCharsResult = append(invalidCharsResult, string(match))
+			}
+		}
+	}
+	invalidString := "Invalid characters in payload based on " + rfc + ": "
+	for _, char := range invalidCharsResult {
+		invalidString += "'" + char + "', "
+	}
+	invalidString = strings.TrimSuffix(invalidString, ", ")
+	return true, invalidString, invalidIndex
+}
@@ -43,6 +43,27 @@ func TestCheckInvalidChars(t *testing.T) {
 			want:       true,
 			wantstring: "Invalid characters in payload based on RFC 3986 and 1738: ' '",
 		},
+		{
+			name:       "headerNameValidator",
+			Payload:    "X-Foo: bar",
+			TestType:   "header_name",
+			want:       true,
+			wantstring: "Invalid characters in payload based on RFC 7230 and 6265: ': '",
+		},
+		{
+			name:       "cookieNameValidator",
+			Payload:    "session=1;",
+			TestType:   "cookie_name",
+			want:       true,
+			wantstring: "Invalid characters in payload based on RFC 7230 and 6265: '=', ';'",
+		},
+		{
+			name:       "queryargNameValidator",
+			Payload:    "a=b&c",
+			TestType:   "queryarg_name",
+			want:       true,
+			wantstring: "Invalid characters in payload based on RFC 3986 and 1738: '=', '&'",
+		},
 		{
 			name:       "DefaultTestcase",
 			Payload:    "admin'/*",
@@ -0,0 +1,184 @@
+package app
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+//maxRetryAfter caps how long a Retry-After header pauses the test requests to a WAF
+const maxRetryAfter = time.Minute
+
+//Limiter paces the test requests sent to a WAF and caps how many of them are in flight. In adaptive
+//mode the rate is halved when the WAF throttles a request, and raised back by a tenth of the
+//configured rate every second without throttling.
+type Limiter struct {
+	mutex    sync.Mutex
+	maxRate  float64
+	minRate  float64
+	rate     float64
+	adaptive bool
+	slots    chan struct{}
+	//next is the time the next request may be sent, later than the pacing when Retry-After asked to wait
+	next time.Time
+	//changed is the time the rate was last lowered or raised
+	changed time.Time
+	//first and last are the times the first and last requests were sent
+	first     time.Time
+	last      time.Time
+	sent      int
+	throttled int
+}
+
+//NewLimiter builds the limiter of a test set, sending up to rate requests per second unless the
+//rate limit of the set has its own rate
+func NewLimiter(rateLimit *config.RateLimit, rate int) *Limiter {
+	if rateLimit == nil {
+		rateLimit = &config.RateLimit{}
+	}
+	if rateLimit.Rate > 0 {
+		rate = rateLimit.Rate
+	}
+	if rate <= 0 {
+		rate = 50
+	}
+	l := &Limiter{
+		maxRate:  float64(rate),
+		minRate:  float64(rateLimit.MinRate),
+		rate:     float64(rate),
+		adaptive: rateLimit.Adaptive,
+	}
+	if l.adaptive && (l.minRate <= 0 || l.minRate > l.maxRate) {
+		l.minRate = 1
+	}
+	if rateLimit.Concurrency > 0 {
+		l.slots = make(chan struct{}, rateLimit.Concurrency)
+	}
+	return l
+}
+
+//Acquire blocks until a request may be in flight to the WAF, returning false without a slot if the
+//stop channel closed first
+func (l *Limiter) Acquire(stopChan <-chan struct{}) bool {
+	if l.slots == nil {
+		return true
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-stopChan:
+		return false
+	}
+}
+
+//Release frees the slot taken by Acquire
+func (l *Limiter) Release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+//Wait blocks until the next request may be sent at the current rate, returning false if the stop
+//channel closed first
+func (l *Limiter) Wait(stopChan <-chan struct{}) bool {
+	l.mutex.Lock()
+	now := time.Now()
+	if l.adaptive && l.rate < l.maxRate && now.Sub(l.changed) >= time.Second {
+		l.rate += l.maxRate / 10
+		if l.rate > l.maxRate {
+			l.rate = l.maxRate
+		}
+		l.changed = now
+	}
+	send := l.next
+	if send.Before(now) {
+		send = now
+	}
+	l.next = send.Add(time.Duration(float64(time.Second) / l.rate))
+	if l.sent == 0 {
+		l.first = send
+	}
+	l.last = send
+	l.sent++
+	l.mutex.Unlock()
+	timer := time.NewTimer(send.Sub(now))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-stopChan:
+		return false
+	}
+}
+
+//Observe adapts the rate to the response of a request, returning true if the WAF throttled it. A
+//response is throttled when it is a 429 or a 503, or carries a Retry-After header, and is not the
+//block response of the WAF.
+func (l *Limiter) Observe(resp *http.Response, blockCon *config.Condition) bool {
+	if !l.adaptive || resp == nil {
+		return false
+	}
+	retryAfter := resp.Header.Get("Retry-After")
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable && retryAfter == "" {
+		return false
+	}
+	if blockCon != nil && resp.StatusCode == blockCon.Code {
+		return false
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.throttled++
+	now := time.Now()
+	//a burst of throttled responses to requests sent together lowers the rate once
+	if now.Sub(l.changed) >= time.Second/2 {
+		l.rate /= 2
+		if l.rate < l.minRate {
+			l.rate = l.minRate
+		}
+		l.changed = now
+	}
+	if wait := parseRetryAfter(retryAfter, now); wait > 0 && now.Add(wait).After(l.next) {
+		l.next = now.Add(wait)
+		l.changed = l.next
+	}
+	return true
+}
+
+//Rate returns the effective rate of the requests sent so far, in requests per second
+func (l *Limiter) Rate() float64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	elapsed := l.last.Sub(l.first).Seconds()
+	if l.sent < 2 || elapsed <= 0 {
+		return float64(l.sent)
+	}
+	return float64(l.sent-1) / elapsed
+}
+
+//Throttled returns the number of throttled responses seen
+func (l *Limiter) Throttled() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.throttled
+}
+
+//parseRetryAfter returns how long a Retry-After header asks to wait, given in seconds or as an HTTP
+//date, up to maxRetryAfter
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		wait = date.Sub(now)
+	}
+	if wait > maxRetryAfter {
+		wait = maxRetryAfter
+	}
+	return wait
+}
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+func TestLimiterWait(t *testing.T) {
+	l := NewLimiter(&config.RateLimit{Rate: 100}, 50)
+	start := time.Now()
+	for i := 0; i < 11; i++ {
+		l.Wait(nil)
+	}
+	//11 requests at 100 per second are spread over 100ms
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("want: at least 100ms\n got: %v", elapsed)
+	}
+	if rate := l.Rate(); rate < 90 || rate > 110 {
+		t.Errorf("want: effective rate around 100\n got: %v", rate)
+	}
+}
+
+func TestLimiterConcurrency(t *testing.T) {
+	l := NewLimiter(&config.RateLimit{Concurrency: 2}, 50)
+	l.Acquire(nil)
+	l.Acquire(nil)
+	acquired := make(chan struct{})
+	go func() {
+		l.Acquire(nil)
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("acquired a third slot")
+	case <-time.After(20 * time.Millisecond):
+	}
+	l.Release()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("slot not released")
+	}
+}
+
+func TestLimiterObserve(t *testing.T) {
+	blockCon := &config.Condition{Code: 503}
+	tests := []struct {
+		name          string
+		adaptive      bool
+		status        int
+		retryAfter    string
+		wantThrottled bool
+		wantRate      float64
+		wantPause     time.Duration
+	}{
+		{name: "notAdaptive", status: 429, wantRate: 40},
+		{name: "allowed", adaptive: true, status: 200, wantRate: 40},
+		{name: "tooManyRequests", adaptive: true, status: 429, wantThrottled: true, wantRate: 20},
+		{name: "blocked", adaptive: true, status: 503, wantRate: 40},
+		{name: "retryAfter", adaptive: true, status: 200, retryAfter: "2", wantThrottled: true, wantRate: 20, wantPause: 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLimiter(&config.RateLimit{Rate: 40, Adaptive: tt.adaptive, MinRate: 1}, 50)
+			resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			if tt.retryAfter != "" {
+				resp.Header.Set("Retry-After", tt.retryAfter)
+			}
+			if got := l.Observe(resp, blockCon); got != tt.wantThrottled {
+				t.Errorf("want: throttled %v\n got: %v", tt.wantThrottled, got)
+			}
+			if l.rate != tt.wantRate {
+				t.Errorf("want: rate %v\n got: %v", tt.wantRate, l.rate)
+			}
+			pause := time.Until(l.next)
+			if pause < 0 {
+				pause = 0
+			}
+			if pause > tt.wantPause || pause < tt.wantPause-time.Second {
+				t.Errorf("want: pause %v\n got: %v", tt.wantPause, pause)
+			}
+		})
+	}
+}
+
+func TestLimiterAdaptive(t *testing.T) {
+	l := NewLimiter(&config.RateLimit{Rate: 40, Adaptive: true, MinRate: 15}, 50)
+	throttled := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	l.Observe(throttled, nil)
+	//responses to requests sent together lower the rate once
+	l.Observe(throttled, nil)
+	if l.rate != 20 {
+		t.Fatalf("want: rate 20\n got: %v", l.rate)
+	}
+	//the rate does not go below the minimum
+	l.changed = time.Now().Add(-time.Second)
+	l.Observe(throttled, nil)
+	if l.rate != 15 {
+		t.Fatalf("want: rate 15\n got: %v", l.rate)
+	}
+	if l.Throttled() != 3 {
+		t.Errorf("want: 3 throttled\n got: %v", l.Throttled())
+	}
+	//the rate is raised by a tenth of the configured rate after a second without throttling
+	l.changed = time.Now().Add(-time.Second)
+	l.Wait(nil)
+	if l.rate != 19 {
+		t.Errorf("want: rate 19\n got: %v", l.rate)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "none", value: "", want: 0},
+		{name: "seconds", value: "30", want: 30 * time.Second},
+		{name: "date", value: "Wed, 01 Jan 2020 00:00:10 GMT", want: 10 * time.Second},
+		{name: "capped", value: "3600", want: maxRetryAfter},
+		{name: "invalid", value: "soon", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.want {
+				t.Errorf("want: %v\n got: %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+//prefetch sends the prefetch request of the test set and places the values extracted from its
+//response in the test request. The prefetch request is sent in the session of the set so values
+//bound to the session, such as CSRF tokens, are valid for the test request.
+func (t *Target) prefetch(testRequest *TestRequest) error {
+	if t.Set == nil || t.Set.Prefetch == nil {
+		return nil
+	}
+	p := t.Set.Prefetch
+	req, err := http.NewRequest(p.Method, setURL(t.Set.URI, p.URL), nil)
+	if err != nil {
+		return err
+	}
+	for _, h := range p.Headers {
+		req.Header.Set(h.Header, h.Value)
+	}
+	t.Session.apply(req)
+	resp, err := t.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("prefetch of %v failed: %v", p.URL, err)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("prefetch of %v failed: %v", p.URL, err)
+	}
+	t.Session.Jar.SetCookies(req.URL, resp.Cookies())
+	for _, v := range p.Values {
+		value, err := extract(&v.Extract, resp, body)
+		if err != nil {
+			return fmt.Errorf("prefetch of %v failed: %v", p.URL, err)
+		}
+		if v.SetHeader != "" {
+			testRequest.Request.Header.Set(v.SetHeader, value)
+			continue
+		}
+		if err := setField(testRequest.Request, v.SetField, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//setField sets the field of a form or JSON object body to the value, adding the field when the body
+//does not have it. Other bodies, and bodies sent compressed, are left as they are.
+func setField(req *http.Request, name string, value string) error {
+	if req.GetBody == nil || req.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+	r, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		field := url.QueryEscape(name) + "=" + url.QueryEscape(value)
+		var fields []string
+		if len(body) > 0 {
+			fields = strings.Split(string(body), "&")
+		}
+		set := false
+		for i, f := range fields {
+			if key, err := url.QueryUnescape(strings.SplitN(f, "=", 2)[0]); err == nil && key == name {
+				fields[i] = field
+				set = true
+				break
+			}
+		}
+		if !set {
+			fields = append(fields, field)
+		}
+		setBody(req, []byte(strings.Join(fields, "&")))
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		raw, _ := jsonMarshal(value)
+		if set, ok := setJSONField(body, name, raw); ok {
+			setBody(req, set)
+			return nil
+		}
+		//bodies made invalid by the payload get the field appended, which wins over an earlier
+		//field of the same name with most parsers
+		trimmed := strings.TrimSpace(string(body))
+		if !strings.HasPrefix(trimmed, "{") || !strings.HasSuffix(trimmed, "}") {
+			return nil
+		}
+		field, _ := jsonMarshal(name)
+		setBody(req, []byte(strings.TrimSuffix(trimmed, "}")+","+string(field)+":"+string(raw)+"}"))
+	}
+	return nil
+}
+
+//setJSONField replaces the value of the top level field of a JSON object with the raw value, or
+//appends the field when the object does not have it. The rest of the body is left byte for byte,
+//keeping the order of the fields and any padding before the payload. It returns false when the
+//body is not a valid JSON object.
+func setJSONField(body []byte, name string, raw []byte) ([]byte, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, false
+	}
+	start, end := -1, -1
+	fields := 0
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, false
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, false
+		}
+		fields++
+		//the last field of the name is the one parsers keep
+		if key, _ := token.(string); key == name {
+			end = int(decoder.InputOffset())
+			start = end - len(value)
+		}
+	}
+	if token, err := decoder.Token(); err != nil || token != json.Delim('}') {
+		return nil, false
+	}
+	var set []byte
+	if start >= 0 {
+		set = append(set, body[:start]...)
+		set = append(set, raw...)
+		return append(set, body[end:]...), true
+	}
+	//the field is added before the closing brace
+	closing := int(decoder.InputOffset()) - 1
+	field, _ := jsonMarshal(name)
+	set = append(set, body[:closing]...)
+	if fields > 0 {
+		set = append(set, ',')
+	}
+	set = append(set, field...)
+	set = append(set, ':')
+	set = append(set, raw...)
+	return append(set, body[closing:]...), true
+}
+
+//jsonMarshal encodes the value without escaping HTML characters, which would alter payloads
+func jsonMarshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,151 @@
+package app
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/sirupsen/logrus"
+)
+
+func TestSetField(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "formReplace",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "csrf=old&foo=<script>",
+			want:        "csrf=a+b%26c&foo=<script>",
+		},
+		{
+			name:        "formAdd",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "foo=bar",
+			want:        "foo=bar&csrf=a+b%26c",
+		},
+		{
+			name:        "json",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"foo":"bar","csrf":"old"}`,
+			want:        `{"foo":"bar","csrf":"a b&c"}`,
+		},
+		{
+			name:        "jsonPadded",
+			contentType: "application/json",
+			body:        `{"pad":"aaaaaaaaaa","zeta":1, "csrf" : {"old":[1,2]},"foo":"<script>"}`,
+			want:        `{"pad":"aaaaaaaaaa","zeta":1, "csrf" : "a b&c","foo":"<script>"}`,
+		},
+		{
+			name:        "jsonPaddedAdd",
+			contentType: "application/json",
+			body:        `{"pad":"aaaaaaaaaa","zeta":1,"foo":"<script>"}`,
+			want:        `{"pad":"aaaaaaaaaa","zeta":1,"foo":"<script>","csrf":"a b&c"}`,
+		},
+		{
+			name:        "jsonEmpty",
+			contentType: "application/json",
+			body:        `{ }`,
+			want:        `{ "csrf":"a b&c"}`,
+		},
+		{
+			name:        "invalidJSON",
+			contentType: "application/json",
+			body:        `{"foo":"ba"r"}`,
+			want:        `{"foo":"ba"r","csrf":"a b&c"}`,
+		},
+		{
+			name:        "other",
+			contentType: "text/plain",
+			body:        "foo",
+			want:        "foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "http://localhost/", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			if err := setField(req, "csrf", "a b&c"); err != nil {
+				t.Fatal(err)
+			}
+			body, _ := ioutil.ReadAll(req.Body)
+			if string(body) != tt.want {
+				t.Errorf("want: %v\n got: %v", tt.want, string(body))
+			}
+			if req.ContentLength != int64(len(tt.want)) {
+				t.Errorf("want: content length %v\n got: %v", len(tt.want), req.ContentLength)
+			}
+		})
+	}
+}
+
+func TestPrefetch(t *testing.T) {
+	tokens := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/form":
+			//every token is bound to a cookie and valid for a single request
+			tokens++
+			http.SetCookie(w, &http.Cookie{Name: "csrf_id", Value: fmt.Sprint(tokens), Path: "/"})
+			fmt.Fprintf(w, `<form><input name="csrf" value="token%d"></form>`, tokens)
+		default:
+			cookie, _ := r.Cookie("csrf_id")
+			r.ParseForm()
+			if cookie == nil || r.PostForm.Get("csrf") != "token"+cookie.Value || r.Header.Get("X-Nonce") != "token"+cookie.Value {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			fmt.Fprint(w, r.PostForm.Get("foo"))
+		}
+	}))
+	defer server.Close()
+
+	testSet := &config.TestSet{
+		Name: "waf",
+		URI:  server.URL + "/",
+		Prefetch: &config.Prefetch{
+			URL:    server.URL + "/form",
+			Method: http.MethodGet,
+			Values: []*config.PrefetchValue{
+				{Extract: config.Extract{Selector: "input[name=csrf]"}, SetField: "csrf"},
+				{Extract: config.Extract{Regex: `value="(\w+)"`, Pattern: regexp.MustCompile(`value="(\w+)"`)}, SetHeader: "X-Nonce"},
+			},
+		},
+	}
+	target, err := NewTarget(testSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &Application{
+		Log:     logrus.New(),
+		Targets: map[string]*Target{"waf": target},
+	}
+	for i := 0; i < 2; i++ {
+		req, err := defaultRequest(testSet, http.MethodPost, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		setBody(req, []byte("foo=bar"))
+		resp, err := a.sendSession(&TestRequest{SetName: "waf", Request: req})
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || string(body) != "bar" {
+			t.Errorf("want: 200 bar\n got: %v %s", resp.StatusCode, body)
+		}
+	}
+	if tokens != 2 {
+		t.Errorf("want: 2 prefetches\n got: %v", tokens)
+	}
+}
@@ -0,0 +1,255 @@
+package app
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/sirupsen/logrus"
+)
+
+//testProxy is a proxy tunneling connections to the addresses it is asked for, recording them
+type testProxy struct {
+	listener net.Listener
+	mutex    sync.Mutex
+	addrs    []string
+}
+
+//newTestProxy serves an HTTP CONNECT proxy, or a SOCKS5 proxy, requiring the credentials alice:secret
+func newTestProxy(t *testing.T, socks bool) *testProxy {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &testProxy{listener: listener}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				var addr string
+				if socks {
+					addr = socksHandshake(conn)
+				} else {
+					addr = connectHandshake(conn)
+				}
+				if addr == "" {
+					return
+				}
+				p.mutex.Lock()
+				p.addrs = append(p.addrs, addr)
+				p.mutex.Unlock()
+				upstream, err := net.Dial("tcp", addr)
+				if err != nil {
+					return
+				}
+				defer upstream.Close()
+				go io.Copy(upstream, conn)
+				io.Copy(conn, upstream)
+			}()
+		}
+	}()
+	return p
+}
+
+//connectHandshake reads a CONNECT request and returns its address when the credentials are valid
+func connectHandshake(conn net.Conn) string {
+	req, err := http.ReadRequest(bufio.NewReader(conn))
+	if err != nil || req.Method != http.MethodConnect {
+		return ""
+	}
+	if req.Header.Get("Proxy-Authorization") != "Basic YWxpY2U6c2VjcmV0" {
+		conn.Write([]byte("HTTP/1.1 407 Proxy Authentication Required\r\nContent-Length: 0\r\n\r\n"))
+		return ""
+	}
+	conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+	return req.Host
+}
+
+//socksHandshake negotiates username and password authentication (RFC 1928, RFC 1929) and returns
+//the address of the connect command when the credentials are valid
+func socksHandshake(conn net.Conn) string {
+	r := bufio.NewReader(conn)
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return ""
+	}
+	methods := make([]byte, header[1])
+	io.ReadFull(r, methods)
+	conn.Write([]byte{5, 2})
+	//username and password sub-negotiation
+	field := func() string {
+		n, _ := r.ReadByte()
+		value := make([]byte, n)
+		io.ReadFull(r, value)
+		return string(value)
+	}
+	if version, err := r.ReadByte(); err != nil || version != 1 {
+		return ""
+	}
+	if user, password := field(), field(); user != "alice" || password != "secret" {
+		conn.Write([]byte{1, 1})
+		return ""
+	}
+	conn.Write([]byte{1, 0})
+	//connect command
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(r, request); err != nil {
+		return ""
+	}
+	var host string
+	switch request[3] {
+	case 1:
+		ip := make([]byte, 4)
+		io.ReadFull(r, ip)
+		host = net.IP(ip).String()
+	case 3:
+		host = field()
+	default:
+		return ""
+	}
+	port := make([]byte, 2)
+	io.ReadFull(r, port)
+	conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port))))
+}
+
+func TestTargetProxy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Host))
+	}))
+	defer server.Close()
+	connectProxy := newTestProxy(t, false)
+	defer connectProxy.listener.Close()
+	socksProxy := newTestProxy(t, true)
+	defer socksProxy.listener.Close()
+
+	tests := []struct {
+		name        string
+		proxy       *testProxy
+		scheme      string
+		credentials string
+		httpVersion string
+		err         bool
+	}{
+		{name: "connect", proxy: connectProxy, scheme: "http", credentials: "alice:secret@"},
+		{name: "connectRaw", proxy: connectProxy, scheme: "http", credentials: "alice:secret@", httpVersion: "1.0"},
+		{name: "connectUnauthorized", proxy: connectProxy, scheme: "http", credentials: "alice:wrong@", err: true},
+		{name: "socks5", proxy: socksProxy, scheme: "socks5", credentials: "alice:secret@"},
+		{name: "socks5Raw", proxy: socksProxy, scheme: "socks5", credentials: "alice:secret@", httpVersion: "1.0"},
+		{name: "socks5Unauthorized", proxy: socksProxy, scheme: "socks5", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSet := &config.TestSet{
+				Name:        "waf",
+				URI:         server.URL + "/",
+				HTTPVersion: tt.httpVersion,
+				Proxy:       tt.scheme + "://" + tt.credentials + tt.proxy.listener.Addr().String(),
+			}
+			target, err := NewTarget(testSet)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a := &Application{Targets: map[string]*Target{"waf": target}}
+			req, err := defaultRequest(testSet, http.MethodGet, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.proxy.mutex.Lock()
+			tt.proxy.addrs = nil
+			tt.proxy.mutex.Unlock()
+			resp, err := a.send(&TestRequest{SetName: "waf", Request: req})
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v", err)
+			}
+			if err != nil {
+				return
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("want: 200\n got: %v", resp.StatusCode)
+			}
+			tt.proxy.mutex.Lock()
+			defer tt.proxy.mutex.Unlock()
+			if len(tt.proxy.addrs) != 1 || tt.proxy.addrs[0] != server.Listener.Addr().String() {
+				t.Errorf("want: tunnel to %v\n got: %v", server.Listener.Addr(), tt.proxy.addrs)
+			}
+		})
+	}
+}
+
+func TestValidateURIProxy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	proxy := newTestProxy(t, false)
+	defer proxy.listener.Close()
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &Application{
+		Log: logrus.New(),
+		TestRun: &config.TestRun{TestSets: []*config.TestSet{{
+			Name:    "waf",
+			URI:     "http://waf.example.com:" + port + "/",
+			Resolve: server.Listener.Addr().String(),
+			Proxy:   "http://alice:secret@" + proxy.listener.Addr().String(),
+		}}},
+	}
+	if err := a.ValidateURI(); err != nil {
+		t.Fatal(err)
+	}
+	proxy.mutex.Lock()
+	defer proxy.mutex.Unlock()
+	if len(proxy.addrs) != 1 || proxy.addrs[0] != server.Listener.Addr().String() {
+		t.Errorf("want: tunnel to %v\n got: %v", server.Listener.Addr(), proxy.addrs)
+	}
+}
+
+func TestValidateURIUnreachable(t *testing.T) {
+	//a listener closed right away gives addresses nothing listens on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := listener.Addr().String()
+	listener.Close()
+	tests := []struct {
+		name    string
+		testSet *config.TestSet
+		want    string
+	}{
+		{
+			name:    "resolve",
+			testSet: &config.TestSet{Name: "waf", URI: "http://waf.example.com:80/", Resolve: closed},
+			want:    "unreachable at " + closed + ",",
+		},
+		{
+			name:    "proxy",
+			testSet: &config.TestSet{Name: "waf", URI: "http://waf.example.com:80/", Proxy: "http://alice:secret@" + closed},
+			want:    "unreachable at waf.example.com:80 through proxy " + closed + ",",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Application{TestRun: &config.TestRun{TestSets: []*config.TestSet{tt.testSet}}}
+			err := a.ValidateURI()
+			//the error names the address dialed, without the credentials of the proxy
+			if err == nil || !strings.Contains(err.Error(), tt.want) || strings.Contains(err.Error(), "secret") {
+				t.Errorf("want: error with %q\n got: %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/signalsciences/waf-testing-framework/pkg/results"
+)
+
+//rateTestProbeInterval is the time between the requests checking whether a WAF still blocks after
+//the burst it started blocking at
+const rateTestProbeInterval = time.Second
+
+//rateBurst is a burst of requests sent at a single rate and the first request of it the WAF blocked
+type rateBurst struct {
+	step         *results.RateStep
+	start        time.Time
+	firstBlock   time.Time
+	blockedAfter int
+}
+
+//RunRateTests runs the rate test of every WAF with one in place of the payload tests. The WAFs are
+//tested one after the other so the bursts sent to a WAF do not slow down the others.
+func (a *Application) RunRateTests() {
+	fmt.Fprintln(a.out(), "begin rate tests...")
+	a.Log.Infoln("begin rate tests...")
+	a.runStart()
+	//calculate counters and process data for the report
+	defer a.runEnd()
+	defer a.Results.ProcessResults()
+	if a.Results.RateResults == nil {
+		a.Results.RateResults = make(map[string]*results.RateResult)
+	}
+	for _, testSet := range a.TestRun.TestSets {
+		if testSet.RateTest == nil {
+			continue
+		}
+		if a.stopped() {
+			a.Results.Incomplete = true
+			return
+		}
+		fmt.Fprintf(a.out(), "testing the rate limiting of %v...\n", testSet.Name)
+		result := a.rateTest(testSet)
+		a.Results.RateResults[testSet.Name] = result
+		if result.Threshold == 0 {
+			a.Log.Infof("%v: not blocked up to %v requests per second\n", testSet.Name, result.MaxRate)
+			continue
+		}
+		a.Log.Infof("%v: blocked at %v requests per second after %v requests, for %vs\n", testSet.Name, result.Threshold, result.BlockedAfter, result.BlockDuration)
+	}
+	if a.stopped() {
+		a.Results.Incomplete = true
+	}
+	fmt.Fprintln(a.out(), "finished rate tests")
+	a.Log.Infoln("finished rate tests")
+}
+
+//rateTest sends the bursts of the rate test of the set at increasing rates, stopping at the first
+//burst the WAF blocks to wait for the WAF to allow requests again
+func (a *Application) rateTest(testSet *config.TestSet) *results.RateResult {
+	rateTest := testSet.RateTest
+	result := &results.RateResult{MaxRate: rateTest.Rates[len(rateTest.Rates)-1]}
+	target := a.target(testSet.Name)
+	sent := 0
+	for _, rate := range rateTest.Rates {
+		burst, stopped := a.rateBurst(target, testSet, rate, &sent)
+		result.Steps = append(result.Steps, burst.step)
+		if burst.step.Blocked == 0 {
+			if stopped {
+				return result
+			}
+			continue
+		}
+		result.Threshold = rate
+		result.BlockedAfter = burst.blockedAfter
+		result.BlockLatency = roundSeconds(burst.firstBlock.Sub(burst.start))
+		if stopped {
+			return result
+		}
+		duration, recovered := a.rateRecovery(target, testSet, burst.firstBlock, &sent)
+		result.BlockDuration = roundSeconds(duration)
+		result.Recovered = recovered
+		return result
+	}
+	return result
+}
+
+//rateBurst sends requests at the rate for the duration of a rate test step without waiting for the
+//responses, returning true if the test was interrupted. sent counts the requests of the rate test.
+func (a *Application) rateBurst(target *Target, testSet *config.TestSet, rate int, sent *int) (*rateBurst, bool) {
+	burst := &rateBurst{step: &results.RateStep{Rate: rate}, start: time.Now()}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	var latency time.Duration
+	ticker := time.NewTicker(time.Second / time.Duration(rate))
+	defer ticker.Stop()
+	deadline := burst.start.Add(testSet.RateTest.StepDuration)
+	stopped := false
+	for i := 0; time.Now().Before(deadline) && !stopped; i++ {
+		req, err := rateRequest(testSet, *sent)
+		*sent++
+		if err != nil {
+			burst.step.Errors++
+			continue
+		}
+		wg.Add(1)
+		go func(i int, req *http.Request) {
+			defer wg.Done()
+			start := time.Now()
+			blocked, err := rateSend(target, testSet, req)
+			mutex.Lock()
+			defer mutex.Unlock()
+			burst.step.Sent++
+			if err != nil {
+				burst.step.Errors++
+				return
+			}
+			latency += time.Since(start)
+			if !blocked {
+				return
+			}
+			burst.step.Blocked++
+			if burst.firstBlock.IsZero() || start.Before(burst.firstBlock) {
+				burst.firstBlock = start
+				burst.blockedAfter = i
+			}
+		}(i, req)
+		select {
+		case <-a.StopChan:
+			stopped = true
+		case <-ticker.C:
+		}
+	}
+	wg.Wait()
+	if answered := burst.step.Sent - burst.step.Errors; answered > 0 {
+		burst.step.Latency = math.Round(float64(latency)/float64(answered)/float64(time.Millisecond)*100) / 100
+	}
+	return burst, stopped
+}
+
+//rateRecovery sends a request every rateTestProbeInterval until the WAF allows one, or the recovery
+//time of the rate test runs out, returning how long the WAF blocked requests since the first block
+func (a *Application) rateRecovery(target *Target, testSet *config.TestSet, firstBlock time.Time, sent *int) (time.Duration, bool) {
+	deadline := time.Now().Add(testSet.RateTest.RecoveryDuration)
+	for time.Now().Before(deadline) {
+		select {
+		case <-a.StopChan:
+			return time.Since(firstBlock), false
+		case <-time.After(rateTestProbeInterval):
+		}
+		req, err := rateRequest(testSet, *sent)
+		*sent++
+		if err != nil {
+			continue
+		}
+		if blocked, err := rateSend(target, testSet, req); err == nil && !blocked {
+			return time.Since(firstBlock), true
+		}
+	}
+	return time.Since(firstBlock), false
+}
+
+//rateRequest builds the nth benign request of a rate test, rotating through the paths of the test
+//and sending a random X-Forwarded-For address when the test rotates it
+func rateRequest(testSet *config.TestSet, n int) (*http.Request, error) {
+	rateTest := testSet.RateTest
+	req, err := defaultRequest(testSet, rateTest.Method, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(rateTest.Paths) > 0 {
+		req.URL.Path = strings.TrimSuffix(req.URL.Path, "/") + rateTest.Paths[n%len(rateTest.Paths)]
+	}
+	if rateTest.RotateXFF {
+		req.Header.Set("X-Forwarded-For", fmt.Sprintf("%d.%d.%d.%d", 1+rand.Intn(223), rand.Intn(256), rand.Intn(256), 1+rand.Intn(254)))
+	}
+	return req, nil
+}
+
+//rateSend sends a request of a rate test, returning true if the WAF blocked it with its block
+//response or a 429
+func rateSend(target *Target, testSet *config.TestSet, req *http.Request) (bool, error) {
+	resp, err := target.Client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+	return blocked(resp, testSet.BlockCondition), nil
+}
+
+//roundSeconds returns the duration in seconds with a precision of 2 decimals
+func roundSeconds(d time.Duration) float64 {
+	return math.Round(d.Seconds()*100) / 100
+}
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/signalsciences/waf-testing-framework/pkg/results"
+	"github.com/sirupsen/logrus"
+)
+
+func TestRateRequest(t *testing.T) {
+	testSet := &config.TestSet{
+		URI:      "http://waf.example.com:80/app/",
+		RateTest: &config.RateTest{Method: "GET", Paths: []string{"/login", "/search"}, RotateXFF: true},
+	}
+	for n, want := range []string{"/app/login", "/app/search", "/app/login"} {
+		req, err := rateRequest(testSet, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if req.URL.Path != want {
+			t.Errorf("want: %v\n got: %v", want, req.URL.Path)
+		}
+		if net.ParseIP(req.Header.Get("X-Forwarded-For")) == nil {
+			t.Errorf("want: X-Forwarded-For address\n got: %q", req.Header.Get("X-Forwarded-For"))
+		}
+	}
+}
+
+func TestRunRateTests(t *testing.T) {
+	//the WAF blocks from the 16th request for a second
+	var mutex sync.Mutex
+	var requests int
+	var blockedUntil time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		requests++
+		if requests == 16 {
+			blockedUntil = time.Now().Add(time.Second)
+		}
+		if time.Now().Before(blockedUntil) {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	blockCon := &config.Condition{Code: http.StatusForbidden}
+	testRun := &config.TestRun{TestSets: []*config.TestSet{
+		{
+			Name:           "limited",
+			URI:            server.URL + "/",
+			BlockCondition: blockCon,
+			RateTest:       &config.RateTest{Method: "GET", Rates: []int{10, 40}, StepDuration: 500 * time.Millisecond, RecoveryDuration: 5 * time.Second},
+		},
+		{Name: "untested", URI: server.URL + "/", BlockCondition: blockCon},
+	}}
+	log := logrus.New()
+	log.SetOutput(ioutil.Discard)
+	a := &Application{
+		Client:  http.DefaultClient,
+		Log:     log,
+		TestRun: testRun,
+		Results: results.InitResults(testRun),
+	}
+	a.RunRateTests()
+
+	if len(a.Results.RateResults) != 1 {
+		t.Fatalf("want: 1 rate result\n got: %v", len(a.Results.RateResults))
+	}
+	result := a.Results.RateResults["limited"]
+	if result == nil {
+		t.Fatal("no rate result")
+	}
+	if result.MaxRate != 40 || result.Threshold != 40 || len(result.Steps) != 2 {
+		t.Fatalf("want: blocked at 40 of 40 in 2 steps\n got: %v of %v in %v steps", result.Threshold, result.MaxRate, len(result.Steps))
+	}
+	if result.Steps[0].Blocked != 0 || result.Steps[0].Sent == 0 {
+		t.Errorf("want: first step allowed\n got: %+v", result.Steps[0])
+	}
+	if result.Steps[1].Blocked == 0 || result.BlockedAfter == 0 {
+		t.Errorf("want: second step blocked\n got: %+v after %v", result.Steps[1], result.BlockedAfter)
+	}
+	if !result.Recovered || result.BlockDuration < 1 || result.BlockDuration > 3 {
+		t.Errorf("want: recovered after about a second\n got: %v after %vs", result.Recovered, result.BlockDuration)
+	}
+}
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andybalholm/brotli"
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+//rawTimeout is the time allowed to send and receive a raw request once connected, unless set for the WAF
+const rawTimeout = 10 * time.Second
+
+//encodeBody compresses the body of the request with the given content encoding
+//and sets the Content-Encoding header
+func encodeBody(req *http.Request, encoding string) error {
+	if encoding == "" || req.Body == nil {
+		return nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "deflate":
+		//the deflate content coding is the zlib format (RFC 7230 section 4.2.2)
+		w = zlib.NewWriter(&buf)
+	case "br":
+		w = brotli.NewWriter(&buf)
+	default:
+		return fmt.Errorf("unknown content encoding: %v", encoding)
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	setBody(req, buf.Bytes())
+	req.Header.Set("Content-Encoding", encoding)
+	return nil
+}
+
+//chunkBody encodes the body using the chunked transfer coding, splitting it into chunks of
+//size bytes and adding the extension to every chunk size line if one is given
+func chunkBody(body []byte, size int, extension string) []byte {
+	if size <= 0 {
+		size = len(body)
+	}
+	var buf bytes.Buffer
+	for len(body) > 0 {
+		n := size
+		if n > len(body) {
+			n = len(body)
+		}
+		buf.WriteString(strconv.FormatInt(int64(n), 16))
+		if extension != "" {
+			buf.WriteString(";" + extension)
+		}
+		buf.WriteString("\r\n")
+		buf.Write(body[:n])
+		buf.WriteString("\r\n")
+		body = body[n:]
+	}
+	buf.WriteString("0\r\n\r\n")
+	return buf.Bytes()
+}
+
+//requestBody returns a copy of the request body without draining the request
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("request body can not be copied")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+//rawRequest serializes the request line and headers of req as they are written on the wire,
+//followed by the already framed message body. Headers net/http would refuse to send, such as
+//Transfer-Encoding, are taken from extra.
+func rawRequest(req *http.Request, proto string, extra http.Header, body []byte) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s %s %s\r\n", req.Method, req.URL.RequestURI(), proto)
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	fmt.Fprintf(&buf, "Host: %s\r\n", host)
+	writeHeaders(&buf, req.Header)
+	writeHeaders(&buf, extra)
+	buf.WriteString("\r\n")
+	buf.Write(body)
+	return buf.Bytes()
+}
+
+//writeHeaders writes the headers in sorted order without canonicalizing or validating them
+func writeHeaders(buf *bytes.Buffer, headers http.Header) {
+	var keys []string
+	for k := range headers {
+		if strings.EqualFold(k, "Host") {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range headers[k] {
+			fmt.Fprintf(buf, "%s: %s\r\n", k, v)
+		}
+	}
+}
+
+//encodeRaw builds the raw bytes of a test request. Bodies of locations using the chunked transfer
+//coding are chunked, other bodies are sent with a Content-Length.
+func encodeRaw(req *http.Request, location *config.TestLocation, proto string) ([]byte, error) {
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if location != nil && location.TransferEncoding == "chunked" {
+		extra := http.Header{"Transfer-Encoding": {"chunked"}}
+		return rawRequest(req, proto, extra, chunkBody(body, location.ChunkSize, location.ChunkExtension)), nil
+	}
+	var extra http.Header
+	if body != nil {
+		extra = http.Header{"Content-Length": {strconv.Itoa(len(body))}}
+	}
+	return rawRequest(req, proto, extra, body), nil
+}
+
+//dial opens a connection to the host of the request, or the resolve address of the set, through the
+//proxy of the set and using TLS for https requests and offering the given application protocols during the TLS handshake
+func (t *Target) dial(req *http.Request, protos ...string) (net.Conn, error) {
+	host := req.URL.Hostname()
+	port := req.URL.Port()
+	if port == "" {
+		port = "80"
+		if req.URL.Scheme == "https" {
+			port = "443"
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), t.connectTimeout())
+	defer cancel()
+	addr := net.JoinHostPort(host, port)
+	if req.URL.Scheme == "https" {
+		return t.dialTLS(ctx, "tcp", addr, t.tlsConfig(host, protos...))
+	}
+	return t.dialContext(ctx, "tcp", addr)
+}
+
+//sendRaw writes the raw request to a new connection and reads the response. The response
+//body is read into memory so the connection can be closed before returning.
+func (t *Target) sendRaw(req *http.Request, raw []byte) (*http.Response, error) {
+	conn, err := t.dial(req)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(t.readTimeout()))
+	if _, err := conn.Write(raw); err != nil {
+		return nil, err
+	}
+	return readRawResponse(bufio.NewReader(conn), req)
+}
+
+//readRawResponse reads a single response from the reader and buffers its body
+func readRawResponse(r *bufio.Reader, req *http.Request) (*http.Response, error) {
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
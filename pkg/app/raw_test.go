@@ -0,0 +1,153 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/google/go-cmp/cmp"
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+func TestChunkBody(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		size      int
+		extension string
+		want      string
+	}{
+		{
+			name: "singleChunk",
+			body: "foo=bar!",
+			want: "8\r\nfoo=bar!\r\n0\r\n\r\n",
+		},
+		{
+			name: "smallChunks",
+			body: "foo=bar!",
+			size: 3,
+			want: "3\r\nfoo\r\n3\r\n=ba\r\n2\r\nr!\r\n0\r\n\r\n",
+		},
+		{
+			name:      "extensions",
+			body:      "foo=bar!",
+			size:      4,
+			extension: "a=b",
+			want:      "4;a=b\r\nfoo=\r\n4;a=b\r\nbar!\r\n0\r\n\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(chunkBody([]byte(tt.body), tt.size, tt.extension))
+			if ok := cmp.Equal(tt.want, got); !ok {
+				diff := cmp.Diff(tt.want, got)
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEncodeBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding string
+	}{
+		{name: "gzip", encoding: "gzip"},
+		{name: "brotli", encoding: "br"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPost, "http://testhost", strings.NewReader("foo=bar!"))
+			if err := encodeBody(req, tt.encoding); err != nil {
+				t.Fatal(err)
+			}
+			if got := req.Header.Get("Content-Encoding"); got != tt.encoding {
+				t.Errorf("want: %v\n got: %v", tt.encoding, got)
+			}
+			body, _ := requestBody(req)
+			var decoded []byte
+			if tt.encoding == "gzip" {
+				r, err := gzip.NewReader(bytes.NewReader(body))
+				if err != nil {
+					t.Fatal(err)
+				}
+				decoded, _ = ioutil.ReadAll(r)
+			} else {
+				decoded, _ = ioutil.ReadAll(brotli.NewReader(bytes.NewReader(body)))
+			}
+			if string(decoded) != "foo=bar!" {
+				t.Errorf("want: %v\n got: %v", "foo=bar!", string(decoded))
+			}
+			if req.ContentLength != int64(len(body)) {
+				t.Errorf("want: %v\n got: %v", len(body), req.ContentLength)
+			}
+		})
+	}
+}
+
+func TestEncodeRaw(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "http://testhost/foo?a=b", strings.NewReader("foo=bar!"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	want := "POST /foo?a=b HTTP/1.1\r\n" +
+		"Host: testhost\r\n" +
+		"Content-Type: application/x-www-form-urlencoded\r\n" +
+		"Transfer-Encoding: chunked\r\n" +
+		"\r\n" +
+		"4\r\nfoo=\r\n4\r\nbar!\r\n0\r\n\r\n"
+	got, err := encodeRaw(req, &config.TestLocation{Location: "body", TransferEncoding: "chunked", ChunkSize: 4}, "HTTP/1.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok := cmp.Equal(want, string(got)); !ok {
+		diff := cmp.Diff(want, string(got))
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSendRaw(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	//echo the decoded body back to show the server saw a valid chunked request
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			return
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		resp := &http.Response{
+			StatusCode:    406,
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			ContentLength: int64(len(body)),
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		}
+		resp.Write(conn)
+	}()
+	req, _ := http.NewRequest(http.MethodPost, "http://"+listener.Addr().String(), strings.NewReader("foo=bar!"))
+	raw, _ := encodeRaw(req, &config.TestLocation{Location: "body", TransferEncoding: "chunked", ChunkSize: 3, ChunkExtension: "x"}, "HTTP/1.1")
+	resp, err := (&Target{}).sendRaw(req, raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != 406 || string(body) != "foo=bar!" {
+		t.Errorf("want: 406 foo=bar!\n got: %v %v", resp.StatusCode, string(body))
+	}
+}
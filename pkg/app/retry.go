@@ -0,0 +1,95 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/sirupsen/logrus"
+)
+
+//sendRetry sends the test request, resending it after a backoff delay while it fails with a transient
+//error, or is throttled by the WAF, and the retry policy of the set allows more attempts. It returns
+//the number of attempts made.
+func (a *Application) sendRetry(testRequest *TestRequest) (*http.Response, int, error) {
+	target := a.target(testRequest.SetName)
+	var policy *config.RetryPolicy
+	if target.Set != nil {
+		policy = target.Set.Retry
+	}
+	//the session credentials are added to the headers by every attempt, so each one starts from the
+	//headers of the test
+	var header http.Header
+	if testRequest.Request != nil {
+		header = testRequest.Request.Header.Clone()
+	}
+	for attempt := 1; ; attempt++ {
+		resp, err := a.sendSession(testRequest)
+		throttled := err == nil && target.Limiter != nil && target.Limiter.Observe(resp, testRequest.BlockCon)
+		if (err == nil && !throttled) || (err != nil && !transient(err)) || policy == nil || attempt >= policy.Attempts {
+			return resp, attempt, err
+		}
+		if throttled {
+			resp.Body.Close()
+			err = fmt.Errorf("throttled with %v", resp.Status)
+		}
+		delay := backoff(policy, attempt)
+		a.Log.WithFields(logrus.Fields{
+			"File":     testRequest.FileName,
+			"Line":     testRequest.Line,
+			"Location": testRequest.Location,
+			"Attempt":  attempt,
+		}).Debugf("retrying in %v after transient error: %v\n", delay, err)
+		select {
+		case <-time.After(delay):
+		case <-a.StopChan:
+			return resp, attempt, err
+		}
+		//retries are sent at the rate of test requests
+		if !a.wait(target) {
+			return resp, attempt, err
+		}
+		//restore the headers and the request body of the failed attempt
+		if testRequest.Request != nil {
+			testRequest.Request.Header = header.Clone()
+		}
+		if testRequest.Request != nil && testRequest.Request.GetBody != nil {
+			testRequest.Request.Body, _ = testRequest.Request.GetBody()
+		}
+	}
+}
+
+//transient returns true for errors a request may not meet again, such as timeouts, refused or
+//reset connections and connections closed before the response was read
+func transient(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	for _, target := range []error{io.EOF, io.ErrUnexpectedEOF, syscall.ECONNREFUSED, syscall.ECONNRESET, syscall.ECONNABORTED, syscall.EPIPE} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+//backoff returns the delay before the retry following the given attempt. The delay doubles from the
+//backoff of the policy up to its maximum, and is shortened by a random jitter of up to half of it so
+//workers failing together do not retry together.
+func backoff(policy *config.RetryPolicy, attempt int) time.Duration {
+	delay := policy.Backoff
+	for i := 1; i < attempt && delay < policy.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > policy.MaxBackoff {
+		delay = policy.MaxBackoff
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/sirupsen/logrus"
+)
+
+//timeoutError is a net.Error reporting a timeout
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "timeout", err: &url.Error{Op: "Get", URL: "http://waf", Err: timeoutError{}}, want: true},
+		{name: "reset", err: &net.OpError{Op: "read", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}, want: true},
+		{name: "refused", err: fmt.Errorf("dial: %w", syscall.ECONNREFUSED), want: true},
+		{name: "eof", err: &url.Error{Op: "Get", URL: "http://waf", Err: io.EOF}, want: true},
+		{name: "tls", err: errors.New("x509: certificate signed by unknown authority")},
+		{name: "proxy", err: errors.New("proxy 127.0.0.1:8080 refused to connect to waf:80: 407 Proxy Authentication Required")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transient(tt.err); got != tt.want {
+				t.Errorf("want: %v\n got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := &config.RetryPolicy{Attempts: 10, Backoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: 100 * time.Millisecond},
+		{attempt: 2, max: 200 * time.Millisecond},
+		{attempt: 3, max: 400 * time.Millisecond},
+		{attempt: 4, max: 800 * time.Millisecond},
+		{attempt: 5, max: time.Second},
+		{attempt: 9, max: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.attempt), func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				if got := backoff(policy, tt.attempt); got < tt.max/2 || got > tt.max {
+					t.Fatalf("want: between %v and %v\n got: %v", tt.max/2, tt.max, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSendRetry(t *testing.T) {
+	var mutex sync.Mutex
+	var requests int
+	var failures int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		requests++
+		if requests <= failures {
+			//close the connection without a response
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		failures     int
+		retry        *config.RetryPolicy
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "noPolicy", failures: 1, wantAttempts: 1, wantErr: true},
+		{name: "recovered", failures: 2, retry: &config.RetryPolicy{Attempts: 3, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}, wantAttempts: 3},
+		{name: "exhausted", failures: 3, retry: &config.RetryPolicy{Attempts: 3, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}, wantAttempts: 3, wantErr: true},
+		{name: "firstAttempt", retry: &config.RetryPolicy{Attempts: 3, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}, wantAttempts: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutex.Lock()
+			requests = 0
+			failures = tt.failures
+			mutex.Unlock()
+			testSet := &config.TestSet{Name: "waf", URI: server.URL + "/", Retry: tt.retry}
+			target, err := NewTarget(testSet)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a := &Application{Log: logrus.New(), Targets: map[string]*Target{"waf": target}}
+			req, err := defaultRequest(testSet, http.MethodPost, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			setBody(req, []byte("payload"))
+			resp, attempts, err := a.sendRetry(&TestRequest{SetName: "waf", Request: req})
+			if attempts != tt.wantAttempts {
+				t.Errorf("want: %v attempts\n got: %v", tt.wantAttempts, attempts)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v", err)
+			}
+			if err != nil {
+				return
+			}
+			defer resp.Body.Close()
+			body, _ := ioutil.ReadAll(resp.Body)
+			if string(body) != "payload" {
+				t.Errorf("want: payload\n got: %q", body)
+			}
+		})
+	}
+}
+
+func TestSendRetrySession(t *testing.T) {
+	var mutex sync.Mutex
+	var cookies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			http.SetCookie(w, &http.Cookie{Name: "sid", Value: "abc", Path: "/"})
+			return
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		cookies = append(cookies, r.Header.Get("Cookie"))
+		if len(cookies) == 1 {
+			//close the connection without a response
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+		}
+	}))
+	defer server.Close()
+
+	testSet := &config.TestSet{
+		Name:  "waf",
+		URI:   server.URL + "/",
+		Login: &config.Login{Type: "form", URL: "/login", Method: http.MethodPost, Form: map[string]string{"user": "alice"}},
+		Retry: &config.RetryPolicy{Attempts: 2, Backoff: time.Millisecond, MaxBackoff: time.Millisecond},
+	}
+	target, err := NewTarget(testSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &Application{Log: logrus.New(), Targets: map[string]*Target{"waf": target}}
+	if err := a.Login(); err != nil {
+		t.Fatal(err)
+	}
+	//a POST so the client does not resend the request itself
+	req, err := defaultRequest(testSet, http.MethodPost, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setBody(req, []byte("payload"))
+	resp, attempts, err := a.sendRetry(&TestRequest{SetName: "waf", Request: req})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if attempts != 2 {
+		t.Fatalf("want: 2 attempts\n got: %v", attempts)
+	}
+	for i, cookie := range cookies {
+		if cookie != "sid=abc" {
+			t.Errorf("want: sid=abc on attempt %v\n got: %q", i+1, cookie)
+		}
+	}
+}
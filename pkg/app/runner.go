@@ -0,0 +1,234 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/signalsciences/waf-testing-framework/pkg/results"
+	"github.com/sirupsen/logrus"
+)
+
+//Test modes of a Runner
+const (
+	ModePayload = "payload"
+	ModeRate    = "rate"
+)
+
+//Runner runs the tests of a test run so the framework can be embedded in other tools. Runners share
+//no state, several of them may run at the same time in a process.
+type Runner struct {
+	testRun    *config.TestRun
+	mode       string
+	workers    int
+	rate       int
+	outputDir  string
+	checkpoint bool
+	resume     bool
+	log        *logrus.Logger
+	errorLog   *logrus.Logger
+	hooks      []Hook
+	out        io.Writer
+}
+
+//Option configures a Runner
+type Option func(*Runner)
+
+//WithMode sets the test mode, ModePayload unless set
+func WithMode(mode string) Option {
+	return func(r *Runner) {
+		r.mode = mode
+	}
+}
+
+//WithWorkers sets the number of requests sent concurrently, 10 unless set
+func WithWorkers(workers int) Option {
+	return func(r *Runner) {
+		r.workers = workers
+	}
+}
+
+//WithRate sets the requests per second sent to each WAF without a rate limit of its own, 50 unless set
+func WithRate(rate int) Option {
+	return func(r *Runner) {
+		r.rate = rate
+	}
+}
+
+//WithOutputDir sets the directory of the checkpoint file and of the reports of the results
+func WithOutputDir(dir string) Option {
+	return func(r *Runner) {
+		r.outputDir = dir
+	}
+}
+
+//WithCheckpoint saves the progress of payload runs to the output directory
+func WithCheckpoint() Option {
+	return func(r *Runner) {
+		r.checkpoint = true
+	}
+}
+
+//WithResume resumes the run saved in the checkpoint of the output directory
+func WithResume() Option {
+	return func(r *Runner) {
+		r.checkpoint = true
+		r.resume = true
+	}
+}
+
+//WithLogger sets the runtime log and the log of the errors met by test requests, which are
+//discarded unless set
+func WithLogger(log *logrus.Logger, errorLog *logrus.Logger) Option {
+	return func(r *Runner) {
+		r.log = log
+		r.errorLog = errorLog
+	}
+}
+
+//WithOutput sets the writer of the progress messages of the runs, such as os.Stdout, which are
+//discarded unless set
+func WithOutput(w io.Writer) Option {
+	return func(r *Runner) {
+		r.out = w
+	}
+}
+
+//WithHooks adds hooks receiving the events of the runs
+func WithHooks(hooks ...Hook) Option {
+	return func(r *Runner) {
+		r.hooks = append(r.hooks, hooks...)
+	}
+}
+
+//New returns a runner of the test run
+func New(testRun *config.TestRun, options ...Option) (*Runner, error) {
+	if testRun == nil {
+		return nil, fmt.Errorf("no test run")
+	}
+	r := &Runner{
+		testRun:   testRun,
+		mode:      ModePayload,
+		workers:   10,
+		rate:      50,
+		outputDir: filepath.FromSlash("./output"),
+	}
+	for _, option := range options {
+		option(r)
+	}
+	switch r.mode {
+	case ModePayload:
+	case ModeRate:
+		if !hasRateTest(testRun) {
+			return nil, fmt.Errorf("the rate mode requires a WAF with a rate_test")
+		}
+		if r.resume {
+			return nil, fmt.Errorf("only the payload mode can be resumed")
+		}
+		r.checkpoint = false
+	default:
+		return nil, fmt.Errorf("unknown mode %v", r.mode)
+	}
+	if r.workers <= 0 {
+		r.workers = 10
+	}
+	if r.rate <= 0 {
+		r.rate = 50
+	}
+	if r.log == nil {
+		r.log = logrus.New()
+		r.log.SetOutput(ioutil.Discard)
+	}
+	if r.errorLog == nil {
+		r.errorLog = r.log
+	}
+	if r.out == nil {
+		r.out = ioutil.Discard
+	}
+	return r, nil
+}
+
+//Run runs the tests and returns their results. Cancelling the context interrupts the run: the
+//requests in flight are finished, and the results of the tests sent are returned, marked as
+//incomplete, along with the error of the context.
+func (r *Runner) Run(ctx context.Context) (*results.Results, error) {
+	targets := make(map[string]*Target)
+	for _, testSet := range r.testRun.TestSets {
+		target, err := NewTarget(testSet)
+		if err != nil {
+			return nil, fmt.Errorf("unable to configure %v: %v", testSet.Name, err)
+		}
+		target.Limiter = NewLimiter(testSet.RateLimit, r.rate)
+		targets[testSet.Name] = target
+	}
+	a := &Application{
+		Targets:            targets,
+		TestRun:            r.testRun,
+		TestsChan:          make(chan *TestRequest, 50),
+		ResultsChan:        make(chan *results.TestResult, 50),
+		StopChan:           make(chan struct{}),
+		Log:                r.log,
+		ErrorLog:           r.errorLog,
+		Results:            results.InitResults(r.testRun),
+		DoneQueuingChan:    make(chan struct{}, 1),
+		DoneProcessingChan: make(chan struct{}, 1),
+		WorkerLimit:        r.workers,
+		Checkpoint:         r.checkpoint,
+		Hooks:              r.hooks,
+		Out:                r.out,
+	}
+	a.Results.Reporting.OutputDir = r.outputDir
+	//ensure we can reach the targeted locations
+	if err := a.ValidateURI(); err != nil {
+		return nil, err
+	}
+	//open the authenticated sessions of WAFs with a login flow
+	if r.mode == ModePayload {
+		if err := a.Login(); err != nil {
+			return nil, err
+		}
+	}
+	//merge the results of the interrupted run and skip the tests it completed
+	if r.resume {
+		if err := a.LoadCheckpoint(); err != nil {
+			return nil, err
+		}
+	}
+	//stop the workers when the context is done, a context done before the run starts sends no tests
+	doneChan := make(chan struct{})
+	defer close(doneChan)
+	if ctx.Err() != nil {
+		close(a.StopChan)
+	} else {
+		go func() {
+			select {
+			case <-ctx.Done():
+				close(a.StopChan)
+			case <-doneChan:
+			}
+		}()
+	}
+	var err error
+	if r.mode == ModeRate {
+		a.RunRateTests()
+	} else {
+		err = a.Run()
+	}
+	if err == nil && a.Results.Incomplete {
+		err = ctx.Err()
+	}
+	return a.Results, err
+}
+
+//hasRateTest returns true if a WAF of the test run has a rate test
+func hasRateTest(testRun *config.TestRun) bool {
+	for _, testSet := range testRun.TestSets {
+		if testSet.RateTest != nil {
+			return true
+		}
+	}
+	return false
+}
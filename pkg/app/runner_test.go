@@ -0,0 +1,187 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/signalsciences/waf-testing-framework/pkg/results"
+)
+
+//runnerTestRun returns a test run sending the false positive payload file to the URI in a header
+func runnerTestRun(uri string) *config.TestRun {
+	return &config.TestRun{
+		Locations: []*config.TestLocation{{Location: "header", Key: "foo"}},
+		TestFiles: []*config.TestFile{{File: filepath.FromSlash("../testdata/payloads/false_positives/fp.txt"), TestType: stringFP}},
+		TestSets: []*config.TestSet{
+			{
+				Name:           "waf",
+				URI:            uri,
+				AllowCondition: &config.Condition{Code: 200},
+				BlockCondition: &config.Condition{Code: 406},
+			},
+		},
+	}
+}
+
+func TestNewRunner(t *testing.T) {
+	tests := []struct {
+		name    string
+		testRun *config.TestRun
+		options []Option
+		wantErr bool
+	}{
+		{name: "defaults", testRun: runnerTestRun("http://localhost/")},
+		{name: "no test run", wantErr: true},
+		{name: "unknown mode", testRun: runnerTestRun("http://localhost/"), options: []Option{WithMode("load")}, wantErr: true},
+		{name: "rate mode without rate tests", testRun: runnerTestRun("http://localhost/"), options: []Option{WithMode(ModeRate)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.testRun, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("want error: %v\n got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRunnerRun(t *testing.T) {
+	//one WAF blocks the false positive payload and the other allows it
+	blocking := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotAcceptable)
+	}))
+	defer blocking.Close()
+	allowing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer allowing.Close()
+
+	tests := []struct {
+		name       string
+		uri        string
+		wantCounts *results.SetCounts
+	}{
+		{name: "blocking", uri: blocking.URL + "/", wantCounts: &results.SetCounts{FpCount: 1, FpPercent: 100, FailPercent: 100, TotalFPTestCount: 1, TotalCount: 1}},
+		{name: "allowing", uri: allowing.URL + "/", wantCounts: &results.SetCounts{TotalFPTestCount: 1, TotalCount: 1}},
+	}
+	//the runners run at the same time
+	var wg sync.WaitGroup
+	got := make([]*results.Results, len(tests))
+	errs := make([]error, len(tests))
+	for i, tt := range tests {
+		runner, err := New(runnerTestRun(tt.uri), WithWorkers(2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got[i], errs[i] = runner.Run(context.Background())
+		}(i)
+	}
+	wg.Wait()
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs[i] != nil {
+				t.Fatal(errs[i])
+			}
+			//the effective rate depends on the speed of the test
+			if diff := cmp.Diff(tt.wantCounts, got[i].SetCounts["waf"], cmpopts.IgnoreFields(results.SetCounts{}, "EffectiveRate")); diff != "" {
+				t.Errorf("counts mismatch (-want +got):\n%s", diff)
+			}
+			if got[i].Incomplete {
+				t.Error("want: complete run")
+			}
+		})
+	}
+}
+
+func TestRunnerCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	runner, err := New(runnerTestRun(server.URL + "/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err := runner.Run(ctx)
+	if err != context.Canceled {
+		t.Errorf("want: %v\n got: %v", context.Canceled, err)
+	}
+	if res == nil || !res.Incomplete {
+		t.Fatal("want: incomplete results")
+	}
+	if res.SetCounts["waf"].UnsentCount != 1 {
+		t.Errorf("want: 1 test not sent\n got: %v", res.SetCounts["waf"].UnsentCount)
+	}
+}
+
+func TestRunnerCancelPaced(t *testing.T) {
+	var mutex sync.Mutex
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		requests++
+	}))
+	defer server.Close()
+	//5 tests paced at a request per second
+	testRun := runnerTestRun(server.URL + "/")
+	testRun.Locations = nil
+	for i := 1; i <= 5; i++ {
+		testRun.Locations = append(testRun.Locations, &config.TestLocation{Location: "header", Key: fmt.Sprintf("foo%d", i)})
+	}
+	runner, err := New(testRun, WithWorkers(5), WithRate(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(300*time.Millisecond, cancel)
+	start := time.Now()
+	res, err := runner.Run(ctx)
+	if err != context.Canceled {
+		t.Errorf("want: %v\n got: %v", context.Canceled, err)
+	}
+	//the workers waiting for their turn stop without sending
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("want: stopped within a second\n got: %v", elapsed)
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if requests != 1 {
+		t.Errorf("want: 1 request sent\n got: %v", requests)
+	}
+	counts := res.SetCounts["waf"]
+	if counts.TotalCount != 1 || counts.UnsentCount != 4 {
+		t.Errorf("want: 1 test run and 4 not sent\n got: %v run and %v not sent", counts.TotalCount, counts.UnsentCount)
+	}
+}
+
+func TestRunnerOutput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	var buf bytes.Buffer
+	runner, err := New(runnerTestRun(server.URL+"/"), WithOutput(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := runner.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	//the progress messages go to the writer of the runner rather than to stdout
+	for _, want := range []string{"begin processing...", "processing lines...", "finished queuing tests"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("want: %q in the output\n got: %q", want, buf.String())
+		}
+	}
+}
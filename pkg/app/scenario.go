@@ -0,0 +1,161 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+//scenarioRun holds the state carried between the steps of one run of a scenario
+type scenarioRun struct {
+	target    *Target
+	variables map[string]string
+	jar       http.CookieJar
+	dump      bytes.Buffer
+}
+
+//sendScenario runs the steps of the scenario of the test request in order, with the cookies set by
+//earlier steps and the variables they extracted. The run stops at the first checked step blocked by
+//the WAF, whose response is returned, or returns the response of the last checked step.
+func (a *Application) sendScenario(testRequest *TestRequest) (*http.Response, error) {
+	target := a.target(testRequest.SetName)
+	scenario := testRequest.TestLocation.Scenario
+	jar, _ := cookiejar.New(nil)
+	payload, _ := jsonMarshal(testRequest.Payload)
+	run := &scenarioRun{
+		target: target,
+		jar:    jar,
+		variables: map[string]string{
+			"payload":            testRequest.Payload,
+			"payload_urlencoded": url.QueryEscape(testRequest.Payload),
+			"payload_json":       string(payload[1 : len(payload)-1]),
+		},
+	}
+	defer func() {
+		testRequest.RawRequest = run.dump.Bytes()
+	}()
+	var checked *http.Response
+	for _, step := range scenario.Steps {
+		resp, body, err := run.send(step, testRequest.Request)
+		if err != nil {
+			return nil, fmt.Errorf("scenario %v: %v: %v", scenario.Name, step.Name, err)
+		}
+		blocked := blockedResponse(resp, testRequest.BlockCon)
+		if step.Check {
+			checked = resp
+			if blocked {
+				return resp, nil
+			}
+		} else if blocked {
+			return nil, fmt.Errorf("scenario %v: %v was blocked before a checked step", scenario.Name, step.Name)
+		}
+		for _, v := range step.Extract {
+			value, err := extract(&v.Extract, resp, body)
+			if err != nil {
+				return nil, fmt.Errorf("scenario %v: %v: %v", scenario.Name, step.Name, err)
+			}
+			run.variables[v.Variable] = value
+		}
+	}
+	return checked, nil
+}
+
+//send sends a step built on the default request of the test set and reads its response
+func (r *scenarioRun) send(step *config.Step, base *http.Request) (*http.Response, []byte, error) {
+	target := r.substitute(step.Path)
+	query := ""
+	if i := strings.Index(target, "?"); i >= 0 {
+		target, query = target[:i], target[i+1:]
+	}
+	req, err := http.NewRequest(step.Method, base.URL.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header = base.Header.Clone()
+	req.Close = true
+	//the path is set as-is so raw payloads are not escaped
+	req.URL = &url.URL{
+		Scheme:   base.URL.Scheme,
+		Host:     base.URL.Host,
+		Opaque:   strings.TrimSuffix(base.URL.Path, "/") + target,
+		RawQuery: query,
+	}
+	for _, h := range step.Headers {
+		req.Header.Set(r.substitute(h.Header), r.substitute(h.Value))
+	}
+	if step.Body != "" {
+		setBody(req, []byte(r.substitute(step.Body)))
+	}
+	header := req.Header.Clone()
+	resp, generation, err := r.do(req, step.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	//a step answered with the session expired is sent once more after logging in again
+	if session := r.target.Session; session != nil && session.expired(resp) {
+		resp.Body.Close()
+		if err := session.relogin(generation); err != nil {
+			return nil, nil, err
+		}
+		req.Header = header
+		if req.GetBody != nil {
+			req.Body, _ = req.GetBody()
+		}
+		resp, _, err = r.do(req, step.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	r.jar.SetCookies(req.URL, resp.Cookies())
+	return resp, body, nil
+}
+
+//do sends the request of a step with the credentials of the session of the test set and the cookies
+//of the run, returning the generation of the session credentials used
+func (r *scenarioRun) do(req *http.Request, name string) (*http.Response, int, error) {
+	generation := 0
+	if r.target.Session != nil {
+		generation = r.target.Session.apply(req)
+	}
+	for _, c := range r.jar.Cookies(req.URL) {
+		req.AddCookie(c)
+	}
+	dump, err := httputil.DumpRequestOut(req, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	fmt.Fprintf(&r.dump, "# %v\r\n%s\r\n", name, dump)
+	resp, err := r.target.Client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp, generation, nil
+}
+
+//substitute replaces the variables referenced in the text
+func (r *scenarioRun) substitute(text string) string {
+	return config.ScenarioVariable.ReplaceAllStringFunc(text, func(ref string) string {
+		return r.variables[config.ScenarioVariable.FindStringSubmatch(ref)[1]]
+	})
+}
+
+//blockedResponse returns true if the response matches the code and headers of the block condition
+func blockedResponse(resp *http.Response, blockCon *config.Condition) bool {
+	if resp.StatusCode != blockCon.Code {
+		return false
+	}
+	return len(blockCon.Headers) == 0 || headerCheck(blockCon.Headers, resp)
+}
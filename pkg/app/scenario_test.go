@@ -0,0 +1,196 @@
+package app
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+func TestSendScenario(t *testing.T) {
+	//a shop whose WAF only inspects the checkout, where items added earlier are rendered
+	var mutex sync.Mutex
+	carts := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		switch {
+		case len(parts) == 1:
+			id := fmt.Sprintf("c%d", len(carts)+1)
+			carts[id] = ""
+			http.SetCookie(w, &http.Cookie{Name: "cart", Value: id, Path: "/"})
+			fmt.Fprintf(w, `{"id": %q}`, id)
+		case len(parts) == 3 && parts[2] == "items":
+			cookie, err := r.Cookie("cart")
+			if err != nil || cookie.Value != parts[1] {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			body, _ := ioutil.ReadAll(r.Body)
+			carts[parts[1]] += string(body)
+		case len(parts) == 3 && parts[2] == "checkout":
+			if strings.Contains(carts[parts[1]], "<script>") {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+			fmt.Fprint(w, carts[parts[1]])
+		}
+	}))
+	defer server.Close()
+
+	scenario := &config.Scenario{
+		Name: "checkout",
+		Steps: []*config.Step{
+			{Name: "create cart", Method: "POST", Path: "/cart", Extract: []*config.Variable{{Variable: "cart_id", Extract: config.Extract{JSONPath: "id"}}}},
+			{Name: "add item", Method: "POST", Path: "/cart/{{cart_id}}/items", Body: "{{payload}}"},
+			{Name: "checkout", Method: "POST", Path: "/cart/{{cart_id}}/checkout", Check: true},
+		},
+	}
+	testSet := &config.TestSet{Name: "waf", URI: server.URL + "/"}
+	target, err := NewTarget(testSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &Application{Targets: map[string]*Target{"waf": target}}
+	tests := []struct {
+		name     string
+		payload  string
+		testType string
+		want     string
+	}{
+		{name: "blockedAtCheckout", payload: "<script>", testType: stringFN, want: stringPass},
+		{name: "allowed", payload: "book", testType: stringFP, want: stringPass},
+		{name: "falseNegative", payload: "<img src=x>", testType: stringFN, want: stringFN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location := &config.TestLocation{Location: "scenario", Scenario: scenario}
+			testRequest := &TestRequest{
+				SetName:      "waf",
+				Payload:      tt.payload,
+				TestType:     tt.testType,
+				TestLocation: location,
+				AllowCon:     &config.Condition{},
+				BlockCon:     &config.Condition{Code: http.StatusNotAcceptable},
+			}
+			if err := a.buildRequest(testRequest, location, testSet); err != nil {
+				t.Fatal(err)
+			}
+			resp, err := a.send(testRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			testRequest.Response = resp
+			got, err := getOutcome(testRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("want: %v\n got: %v", tt.want, got)
+			}
+			if steps := strings.Count(string(testRequest.RawRequest), "\r\n# "); steps != 2 {
+				t.Errorf("want: 3 steps in the request dump\n got: %s", testRequest.RawRequest)
+			}
+		})
+	}
+	//a step blocked before the checked step makes the run an error
+	blocking := &config.Scenario{
+		Name: "blocked",
+		Steps: []*config.Step{
+			{Name: "add item", Method: "POST", Path: "/cart/c9/items", Body: "{{payload}}"},
+			{Name: "checkout", Method: "POST", Path: "/cart/c9/checkout", Check: true},
+		},
+	}
+	testRequest := &TestRequest{
+		SetName:      "waf",
+		Payload:      "foo",
+		TestLocation: &config.TestLocation{Location: "scenario", Scenario: blocking},
+		BlockCon:     &config.Condition{Code: http.StatusForbidden},
+	}
+	a.buildRequest(testRequest, testRequest.TestLocation, testSet)
+	if _, err := a.send(testRequest); err == nil {
+		t.Error("want error for a step blocked before the checked step")
+	}
+}
+
+func TestSendScenarioSession(t *testing.T) {
+	//the first session expires after one request
+	var mutex sync.Mutex
+	logins, requests := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if r.URL.Path == "/login" {
+			logins++
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: fmt.Sprint(logins), Path: "/"})
+			return
+		}
+		cookie, err := r.Cookie("session")
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		requests++
+		if cookie.Value == "1" && requests > 1 {
+			http.Redirect(w, r, "/login", http.StatusFound)
+			return
+		}
+		fmt.Fprint(w, cookie.Value)
+	}))
+	defer server.Close()
+
+	testSet := &config.TestSet{
+		Name: "waf",
+		URI:  server.URL + "/",
+		Login: &config.Login{
+			URL:     "/login",
+			Method:  http.MethodPost,
+			Expired: &config.Condition{Code: http.StatusFound, Headers: []*config.Header{{Header: "Location", Value: "/login"}}},
+		},
+	}
+	target, err := NewTarget(testSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &Application{Targets: map[string]*Target{"waf": target}}
+	if err := a.Login(); err != nil {
+		t.Fatal(err)
+	}
+	scenario := &config.Scenario{
+		Name: "session",
+		Steps: []*config.Step{
+			{Name: "open", Method: "GET", Path: "/open"},
+			{Name: "check", Method: "POST", Path: "/check", Body: "{{payload}}", Check: true},
+		},
+	}
+	location := &config.TestLocation{Location: "scenario", Scenario: scenario}
+	testRequest := &TestRequest{
+		SetName:      "waf",
+		Payload:      "book",
+		TestType:     stringFP,
+		TestLocation: location,
+		AllowCon:     &config.Condition{Code: http.StatusOK},
+		BlockCon:     &config.Condition{Code: http.StatusNotAcceptable},
+	}
+	if err := a.buildRequest(testRequest, location, testSet); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := a.sendSession(testRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	//the checked step is sent again in the new session rather than reported with the expired one
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(body) != "2" {
+		t.Errorf("want: 200 in the second session\n got: %v %q", resp.StatusCode, body)
+	}
+	if logins != 2 {
+		t.Errorf("want: 2 logins\n got: %v", logins)
+	}
+}
@@ -0,0 +1,196 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+//Session holds the cookies and token of a WAF logged in to with its login flow, or the cookies of
+//the prefetch step of a WAF without one. The cookie jar is shared by every test request of the WAF
+//and updated with the cookies the application sets.
+type Session struct {
+	Login  *config.Login
+	Jar    http.CookieJar
+	uri    string
+	client *http.Client
+	mutex  sync.RWMutex
+	token  string
+	//generation counts the logins so workers seeing the session expire together log in once
+	generation int
+}
+
+//newSession builds the session of a login flow for the test set URI, logging in with a client sharing
+//the transport and the request timeout of the WAF client and following redirects so cookies set along
+//the way are kept
+func newSession(login *config.Login, uri string, transport http.RoundTripper, timeout time.Duration) *Session {
+	jar, _ := cookiejar.New(nil)
+	return &Session{
+		Login: login,
+		Jar:   jar,
+		uri:   uri,
+		client: &http.Client{
+			Transport: transport,
+			Jar:       jar,
+			Timeout:   timeout,
+		},
+	}
+}
+
+//login runs the login flow, keeping the cookies of the responses and the extracted token
+func (s *Session) login() error {
+	var body io.Reader
+	l := s.Login
+	if len(l.Form) > 0 {
+		form := url.Values{}
+		for k, v := range l.Form {
+			form.Set(k, v)
+		}
+		body = strings.NewReader(form.Encode())
+	} else if l.Body != "" {
+		body = strings.NewReader(l.Body)
+	}
+	req, err := http.NewRequest(l.Method, setURL(s.uri, l.URL), body)
+	if err != nil {
+		return err
+	}
+	if len(l.Form) > 0 {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	for _, h := range l.Headers {
+		req.Header.Set(h.Header, h.Value)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("login to %v failed: %v", l.URL, err)
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("login to %v failed: %v", l.URL, err)
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("login to %v failed with status %v", l.URL, resp.Status)
+	}
+	if l.Token != nil {
+		token, err := extract(l.Token, resp, respBody)
+		if err != nil {
+			return fmt.Errorf("login to %v returned no token: %v", l.URL, err)
+		}
+		s.token = token
+	}
+	s.generation++
+	return nil
+}
+
+//setURL returns the URL of a login or prefetch request, sending paths to the host of the test set URI
+func setURL(uri string, target string) string {
+	if !strings.HasPrefix(target, "/") {
+		return target
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return target
+	}
+	return u.Scheme + "://" + u.Host + target
+}
+
+//relogin runs the login flow again unless it already ran since the given generation of the session
+func (s *Session) relogin(generation int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.generation != generation {
+		return nil
+	}
+	return s.login()
+}
+
+//apply adds the token and the cookies of the session to the request, returning the generation of
+//the credentials used
+func (s *Session) apply(req *http.Request) int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if s.token != "" {
+		req.Header.Set(s.Login.TokenHeader, s.Login.TokenPrefix+s.token)
+	}
+	for _, c := range s.Jar.Cookies(req.URL) {
+		req.AddCookie(c)
+	}
+	return s.generation
+}
+
+//expired returns true if the response matches the session expired condition of the login flow
+func (s *Session) expired(resp *http.Response) bool {
+	if s.Login == nil || s.Login.Expired == nil || resp == nil {
+		return false
+	}
+	con := s.Login.Expired
+	if con.Code != 0 && resp.StatusCode != con.Code {
+		return false
+	}
+	return headerCheck(con.Headers, resp)
+}
+
+//Login runs the login flow of every WAF that has one before the tests are sent
+func (a *Application) Login() error {
+	for name, target := range a.Targets {
+		if target.Session == nil || target.Session.Login == nil {
+			continue
+		}
+		target.Session.mutex.Lock()
+		err := target.Session.login()
+		target.Session.mutex.Unlock()
+		if err != nil {
+			return fmt.Errorf("%v: %v", name, err)
+		}
+	}
+	return nil
+}
+
+//sendSession sends the test request with the values of the prefetch step and the credentials of the
+//session of its WAF. When the response shows the session expired the WAF is logged in to again and
+//the request sent once more.
+func (a *Application) sendSession(testRequest *TestRequest) (*http.Response, error) {
+	target := a.target(testRequest.SetName)
+	session := target.Session
+	//the steps of a scenario carry the session themselves, logging in again when it expires
+	if session == nil || (testRequest.TestLocation != nil && testRequest.TestLocation.Scenario != nil) {
+		return a.send(testRequest)
+	}
+	req := testRequest.Request
+	header := req.Header.Clone()
+	if err := target.prefetch(testRequest); err != nil {
+		return nil, err
+	}
+	generation := session.apply(req)
+	resp, err := a.send(testRequest)
+	if err != nil || !session.expired(resp) {
+		if err == nil {
+			session.Jar.SetCookies(req.URL, resp.Cookies())
+		}
+		return resp, err
+	}
+	resp.Body.Close()
+	a.Log.Infof("session of %v expired, logging in again\n", testRequest.SetName)
+	if err := session.relogin(generation); err != nil {
+		return nil, err
+	}
+	req.Header = header
+	if req.GetBody != nil {
+		req.Body, _ = req.GetBody()
+	}
+	testRequest.RawRequest = nil
+	if err := target.prefetch(testRequest); err != nil {
+		return nil, err
+	}
+	session.apply(req)
+	return a.send(testRequest)
+}
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+	"github.com/sirupsen/logrus"
+)
+
+func TestSession(t *testing.T) {
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			r.ParseForm()
+			if r.PostForm.Get("user") != "alice" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			logins++
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: fmt.Sprint(logins), Path: "/"})
+			fmt.Fprintf(w, `{"access_token": "token%d"}`, logins)
+		default:
+			//the first session expires after one request
+			cookie, err := r.Cookie("session")
+			if err != nil || cookie.Value == "1" && r.Header.Get("X-Seen") != "" {
+				http.Redirect(w, r, "/login", http.StatusFound)
+				return
+			}
+			fmt.Fprintf(w, "%v %v", cookie.Value, r.Header.Get("Authorization"))
+		}
+	}))
+	defer server.Close()
+
+	testSet := &config.TestSet{
+		Name: "waf",
+		URI:  server.URL + "/",
+		Login: &config.Login{
+			Type:        "form",
+			URL:         server.URL + "/login",
+			Method:      http.MethodPost,
+			Form:        map[string]string{"user": "alice"},
+			Token:       &config.Extract{JSONPath: "access_token"},
+			TokenHeader: "Authorization",
+			TokenPrefix: "Bearer ",
+			Expired:     &config.Condition{Code: http.StatusFound, Headers: []*config.Header{{Header: "Location", Value: "/login"}}},
+		},
+	}
+	target, err := NewTarget(testSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &Application{
+		Log:     logrus.New(),
+		Targets: map[string]*Target{"waf": target},
+	}
+	if err := a.Login(); err != nil {
+		t.Fatal(err)
+	}
+
+	send := func(header string) string {
+		req, err := defaultRequest(testSet, http.MethodGet, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if header != "" {
+			req.Header.Set("X-Seen", header)
+		}
+		resp, err := a.sendSession(&TestRequest{SetName: "waf", Request: req})
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		return string(body)
+	}
+	if got, want := send(""), "1 Bearer token1"; got != want {
+		t.Errorf("want: %v\n got: %v", want, got)
+	}
+	//the expired session is logged in to again and the request resent with the new credentials
+	if got, want := send("1"), "2 Bearer token2"; got != want {
+		t.Errorf("want: %v\n got: %v", want, got)
+	}
+	if logins != 2 {
+		t.Errorf("want: 2 logins\n got: %v", logins)
+	}
+	//a request must not carry the cookies of the previous session
+	req, _ := defaultRequest(testSet, http.MethodGet, nil)
+	target.Session.apply(req)
+	if cookies := req.Header.Get("Cookie"); strings.Count(cookies, "session=") != 1 {
+		t.Errorf("want one session cookie\n got: %v", cookies)
+	}
+}
+
+func TestSessionLoginFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	session := newSession(&config.Login{Type: "bearer", URL: "/", Method: http.MethodPost}, server.URL+"/", nil, clientTimeout)
+	if err := session.login(); err == nil {
+		t.Error("want login error")
+	}
+}
+
+func TestSessionLoginTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+	}))
+	defer server.Close()
+	//the login of a WAF with timeouts of its own gives up with its test requests
+	testSet := &config.TestSet{
+		Name:           "waf",
+		URI:            server.URL + "/",
+		ConnectTimeout: 50 * time.Millisecond,
+		ReadTimeout:    50 * time.Millisecond,
+		Login:          &config.Login{URL: "/login", Method: http.MethodPost},
+	}
+	target, err := NewTarget(testSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &Application{Targets: map[string]*Target{"waf": target}}
+	start := time.Now()
+	if err := a.Login(); err == nil {
+		t.Error("want login error")
+	}
+	if elapsed := time.Since(start); elapsed >= 500*time.Millisecond {
+		t.Errorf("want: login timed out after 100ms\n got: %v", elapsed)
+	}
+}
@@ -0,0 +1,164 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+const (
+	//smugglingTimeout is how long a probe response is waited for before the
+	//backend is assumed to be waiting on body bytes the WAF did not forward
+	smugglingTimeout = 5 * time.Second
+	//smugglingPath is the path of the smuggled request, chosen so a poisoned
+	//follow-up request receives a different response than the baseline
+	smugglingPath = "/waftf-smuggled"
+)
+
+//smuggledRequest builds the request hidden inside the probe body, carrying the payload in a query argument.
+//The payload is always escaped since it has to survive as part of a request line on the backend.
+func smuggledRequest(req *http.Request, key string, payload string) string {
+	if key == "" {
+		key = "foo"
+	}
+	return fmt.Sprintf("GET %s?%s=%s HTTP/1.1\r\nHost: %s\r\n", smugglingPath, key, url.QueryEscape(payload), req.URL.Host)
+}
+
+//smugglingProbe builds the raw bytes of a desync probe carrying the payload in a smuggled request.
+//	CL.TE:    the WAF uses Content-Length and forwards everything, the backend stops at the empty chunk
+//	TE.CL:    the WAF uses Transfer-Encoding and forwards everything, the backend stops after Content-Length
+//	TE.TE:    like CL.TE with an obfuscated duplicate Transfer-Encoding header one side ignores
+//	HTTP/1.0: a keep-alive HTTP/1.0 request followed by a pipelined request on the same connection
+func smugglingProbe(req *http.Request, probe string, key string, payload string) ([]byte, error) {
+	smuggled := smuggledRequest(req, key, payload)
+	headers := req.Header.Clone()
+	headers.Del("Content-Length")
+	headers.Set("Connection", "keep-alive")
+	switch probe {
+	case "CL.TE", "TE.TE":
+		//the unterminated header swallows the request line of whatever follows
+		body := "0\r\n\r\n" + smuggled + "X-Ignore: X"
+		extra := http.Header{
+			"Content-Length":    {strconv.Itoa(len(body))},
+			"Transfer-Encoding": {"chunked"},
+		}
+		if probe == "TE.TE" {
+			extra["Transfer-encoding"] = []string{"x"}
+		}
+		return rawRequest(withHeaders(req, headers), "HTTP/1.1", extra, []byte(body)), nil
+	case "TE.CL":
+		//the smuggled body length covers the trailing empty chunk and the start of the next request
+		smuggled += "Content-Type: application/x-www-form-urlencoded\r\nContent-Length: 15\r\n\r\nx=1"
+		size := strconv.FormatInt(int64(len(smuggled)), 16)
+		body := size + "\r\n" + smuggled + "\r\n0\r\n\r\n"
+		extra := http.Header{
+			"Content-Length":    {strconv.Itoa(len(size) + 2)},
+			"Transfer-Encoding": {"chunked"},
+		}
+		return rawRequest(withHeaders(req, headers), "HTTP/1.1", extra, []byte(body)), nil
+	case "HTTP/1.0":
+		extra := http.Header{"Content-Length": {"0"}}
+		probe := rawRequest(withHeaders(req, headers), "HTTP/1.0", extra, nil)
+		return append(probe, []byte(smuggled+"\r\n")...), nil
+	default:
+		return nil, fmt.Errorf("unknown smuggling probe: %v", probe)
+	}
+}
+
+//withHeaders returns a shallow copy of the request using the given headers
+func withHeaders(req *http.Request, headers http.Header) *http.Request {
+	r := req.Clone(context.Background())
+	r.Header = headers
+	return r
+}
+
+//followUpRequest is a plain request to the test URI used to detect a poisoned backend connection
+func followUpRequest(req *http.Request) []byte {
+	r := req.Clone(context.Background())
+	r.Method = http.MethodGet
+	r.URL.RawQuery = ""
+	return rawRequest(r, "HTTP/1.1", nil, nil)
+}
+
+//blocked returns true if the response is the block response of the WAF
+func blocked(resp *http.Response, blockCon *config.Condition) bool {
+	return blockCon != nil && resp.StatusCode == blockCon.Code && headerCheck(blockCon.Headers, resp)
+}
+
+//sendSmuggling sends the desync probe of the test request and checks for signs of a desync:
+//the probe timing out, the pipelined request of the HTTP/1.0 probe being allowed while the WAF
+//blocks it sent directly, or a
+//follow-up request receiving a different response than a baseline request sent beforehand.
+//The probe response is returned so the block decision is made like for any other location.
+func (a *Application) sendSmuggling(testRequest *TestRequest) (*http.Response, error) {
+	req := testRequest.Request
+	location := testRequest.TestLocation
+	target := a.target(testRequest.SetName)
+	baseline, err := target.sendRaw(req, followUpRequest(req))
+	if err != nil {
+		return nil, err
+	}
+	probe, err := smugglingProbe(req, location.Probe, location.Key, testRequest.Payload)
+	if err != nil {
+		return nil, err
+	}
+	testRequest.RawRequest = probe
+	conn, err := target.dial(req)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(smugglingTimeout))
+	if _, err := conn.Write(probe); err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := readRawResponse(reader, req)
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			testRequest.Desync = fmt.Sprintf("probe timed out after %v", smugglingTimeout)
+			//no response means no block decision was made, which is reported as an allow
+			return &http.Response{
+				Status:     "000 probe timed out",
+				Proto:      "HTTP/1.1",
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+				Request:    req,
+			}, nil
+		}
+		return nil, err
+	}
+	if location.Probe == "HTTP/1.0" {
+		//servers honouring keep-alive answer the pipelined request too, which is only a desync when
+		//it got past the WAF that blocks the same request sent on its own connection
+		second, err := readRawResponse(reader, req)
+		if err != nil || blocked(second, testRequest.BlockCon) {
+			return resp, nil
+		}
+		direct, err := target.sendRaw(req, []byte(smuggledRequest(req, location.Key, testRequest.Payload)+"\r\n"))
+		if err == nil && blocked(direct, testRequest.BlockCon) {
+			testRequest.Desync = fmt.Sprintf("pipelined request answered with status %v while the request sent directly is blocked with status %v", second.StatusCode, direct.StatusCode)
+		}
+		return resp, nil
+	}
+	followUp, err := target.sendRaw(req, followUpRequest(req))
+	if err != nil {
+		testRequest.Desync = fmt.Sprintf("follow-up request failed: %v", err)
+		return resp, nil
+	}
+	if followUp.StatusCode != baseline.StatusCode {
+		testRequest.Desync = fmt.Sprintf("follow-up status %v differs from baseline status %v", followUp.StatusCode, baseline.StatusCode)
+	}
+	return resp, nil
+}
@@ -0,0 +1,210 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+func TestSmugglingProbe(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "http://testhost/", nil)
+	req.Header.Set("Connection", "close")
+	tests := []struct {
+		name  string
+		probe string
+		want  string
+	}{
+		{
+			name:  "CL.TE",
+			probe: "CL.TE",
+			want: "POST / HTTP/1.1\r\n" +
+				"Host: testhost\r\n" +
+				"Connection: keep-alive\r\n" +
+				"Content-Length: 73\r\n" +
+				"Transfer-Encoding: chunked\r\n" +
+				"\r\n" +
+				"0\r\n\r\n" +
+				"GET /waftf-smuggled?foo=bar%21 HTTP/1.1\r\nHost: testhost\r\nX-Ignore: X",
+		},
+		{
+			name:  "TE.CL",
+			probe: "TE.CL",
+			want: "POST / HTTP/1.1\r\n" +
+				"Host: testhost\r\n" +
+				"Connection: keep-alive\r\n" +
+				"Content-Length: 4\r\n" +
+				"Transfer-Encoding: chunked\r\n" +
+				"\r\n" +
+				"83\r\n" +
+				"GET /waftf-smuggled?foo=bar%21 HTTP/1.1\r\nHost: testhost\r\n" +
+				"Content-Type: application/x-www-form-urlencoded\r\nContent-Length: 15\r\n\r\nx=1" +
+				"\r\n0\r\n\r\n",
+		},
+		{
+			name:  "HTTP/1.0",
+			probe: "HTTP/1.0",
+			want: "POST / HTTP/1.0\r\n" +
+				"Host: testhost\r\n" +
+				"Connection: keep-alive\r\n" +
+				"Content-Length: 0\r\n" +
+				"\r\n" +
+				"GET /waftf-smuggled?foo=bar%21 HTTP/1.1\r\nHost: testhost\r\n\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := smugglingProbe(req, tt.probe, "", "bar!")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok := cmp.Equal(tt.want, string(got)); !ok {
+				diff := cmp.Diff(tt.want, string(got))
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSendSmuggling(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	//a server that is not vulnerable answers every request on its own connection with a 406
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				req, err := http.ReadRequest(bufio.NewReader(conn))
+				if err != nil {
+					return
+				}
+				ioutil.ReadAll(req.Body)
+				resp := &http.Response{
+					StatusCode: 406,
+					ProtoMajor: 1,
+					ProtoMinor: 1,
+					Close:      true,
+					Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+				}
+				resp.Write(conn)
+			}(conn)
+		}
+	}()
+	req, _ := http.NewRequest(http.MethodPost, "http://"+listener.Addr().String(), nil)
+	testRequest := &TestRequest{
+		Payload:      "bar!",
+		Request:      req,
+		TestLocation: &config.TestLocation{Location: "smuggling", Probe: "CL.TE"},
+	}
+	a := &Application{}
+	resp, err := a.sendSmuggling(testRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != 406 {
+		t.Errorf("want: %v\n got: %v", 406, resp.StatusCode)
+	}
+	if testRequest.Desync != "" {
+		t.Errorf("unexpected desync: %v", testRequest.Desync)
+	}
+	if !strings.Contains(string(testRequest.RawRequest), "/waftf-smuggled?foo=bar%21") {
+		t.Errorf("probe does not carry the payload: %s", testRequest.RawRequest)
+	}
+}
+
+func TestSendSmugglingPipelined(t *testing.T) {
+	tests := []struct {
+		name string
+		//status returns the status of the nth request of a connection to the path
+		status     func(n int, path string) int
+		wantDesync bool
+	}{
+		{
+			name: "pipelined request blocked",
+			status: func(n int, path string) int {
+				if path == smugglingPath {
+					return 406
+				}
+				return 200
+			},
+		},
+		{
+			name:   "keep-alive server without a WAF",
+			status: func(n int, path string) int { return 200 },
+		},
+		{
+			name: "pipelined request not inspected",
+			status: func(n int, path string) int {
+				if n == 0 && path == smugglingPath {
+					return 406
+				}
+				return 200
+			},
+			wantDesync: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer listener.Close()
+			//a keep-alive server answering every request of a connection
+			go func() {
+				for {
+					conn, err := listener.Accept()
+					if err != nil {
+						return
+					}
+					go func(conn net.Conn) {
+						defer conn.Close()
+						reader := bufio.NewReader(conn)
+						for n := 0; ; n++ {
+							req, err := http.ReadRequest(reader)
+							if err != nil {
+								return
+							}
+							ioutil.ReadAll(req.Body)
+							resp := &http.Response{
+								StatusCode: tt.status(n, req.URL.Path),
+								ProtoMajor: 1,
+								ProtoMinor: 1,
+								Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+							}
+							resp.Write(conn)
+						}
+					}(conn)
+				}
+			}()
+			req, _ := http.NewRequest(http.MethodGet, "http://"+listener.Addr().String(), nil)
+			testRequest := &TestRequest{
+				Payload:      "bar!",
+				Request:      req,
+				BlockCon:     &config.Condition{Code: 406},
+				TestLocation: &config.TestLocation{Location: "smuggling", Probe: "HTTP/1.0"},
+			}
+			a := &Application{}
+			if _, err := a.sendSmuggling(testRequest); err != nil {
+				t.Fatal(err)
+			}
+			if (testRequest.Desync != "") != tt.wantDesync {
+				t.Errorf("want desync: %v\n got: %q", tt.wantDesync, testRequest.Desync)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+//buildTemplateRequest builds the request of a location created from a request template, placing the
+//payload in the parameter of the location and the template values in every other parameter. The
+//payload is encoded following the same options as the location it is injected into.
+func (a *Application) buildTemplateRequest(testRequest *TestRequest, location *config.TestLocation, testSet *config.TestSet) error {
+	t := location.Template
+	payload := testRequest.Payload
+	injected := func(inject string, p *config.Parameter) bool {
+		return location.Inject == inject && p.Name == location.Key
+	}
+	//path
+	path := t.Path
+	for _, p := range t.PathParams {
+		value := url.PathEscape(p.Value)
+		if injected("path", p) {
+			value = payload
+			if a.TestRun.URLEncodePath {
+				value = url.PathEscape(payload)
+			}
+			testRequest.CheckPayload = value
+		}
+		path = strings.Replace(path, "{"+p.Name+"}", value, -1)
+	}
+	//query
+	var query []string
+	for _, p := range t.Query {
+		value := url.QueryEscape(p.Value)
+		if injected("queryarg", p) {
+			value = payload
+			if a.TestRun.URLEncodeQuery {
+				value = url.QueryEscape(payload)
+			}
+			testRequest.CheckPayload = value
+		}
+		query = append(query, url.QueryEscape(p.Name)+"="+value)
+	}
+	//body
+	body := t.Body
+	if len(t.BodyParams) > 0 {
+		var fields []string
+		for _, p := range t.BodyParams {
+			if t.JSONBody() {
+				value := p.Value
+				if injected("body", p) {
+					value = `"` + payload + `"`
+					testRequest.CheckPayload = payload
+				}
+				fields = append(fields, `"`+p.Name+`":`+value)
+				continue
+			}
+			value := url.QueryEscape(p.Value)
+			if injected("body", p) {
+				value = payload
+				if a.TestRun.PostBodyType == "urlencoded" {
+					value = url.QueryEscape(payload)
+				}
+				testRequest.CheckPayload = value
+			}
+			fields = append(fields, url.QueryEscape(p.Name)+"="+value)
+		}
+		if t.JSONBody() {
+			body = "{" + strings.Join(fields, ",") + "}"
+		} else {
+			body = strings.Join(fields, "&")
+		}
+	}
+	req, err := defaultRequest(testSet, t.Method, nil)
+	if err != nil {
+		return err
+	}
+	if body != "" {
+		setBody(req, []byte(body))
+		req.Header.Set("Content-Type", t.ContentType)
+	}
+	req.Close = true
+	//the path is set as-is so raw payloads are not escaped
+	req.URL = &url.URL{
+		Scheme:   req.URL.Scheme,
+		Host:     req.URL.Host,
+		Opaque:   strings.TrimSuffix(req.URL.Path, "/") + path,
+		RawQuery: strings.Join(query, "&"),
+	}
+	//headers
+	for _, p := range t.Headers {
+		value := p.Value
+		if injected("header", p) {
+			value = payload
+			if a.TestRun.URLEncodeHeader {
+				value = url.QueryEscape(payload)
+			}
+			testRequest.CheckPayload = value
+		}
+		req.Header.Set(p.Name, value)
+	}
+	//cookies
+	var cookies []string
+	for _, p := range t.Cookies {
+		value := p.Value
+		if injected("cookie", p) {
+			value = payload
+			if a.TestRun.B64EncodeCookie {
+				value = base64.RawStdEncoding.EncodeToString([]byte(payload))
+			}
+			testRequest.CheckPayload = value
+		}
+		cookies = append(cookies, p.Name+"="+value)
+	}
+	if len(cookies) > 0 {
+		req.Header.Add("Cookie", strings.Join(cookies, "; "))
+	}
+	testRequest.Request = req
+	return nil
+}
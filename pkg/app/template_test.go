@@ -0,0 +1,96 @@
+package app
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+func TestBuildTemplateRequest(t *testing.T) {
+	template := &config.RequestTemplate{
+		Name:        "updateUser",
+		Method:      "PUT",
+		Path:        "/users/{id}",
+		PathParams:  []*config.Parameter{{Name: "id", Value: "1 2"}},
+		Query:       []*config.Parameter{{Name: "verbose", Value: "true"}},
+		Headers:     []*config.Parameter{{Name: "X-Tenant", Value: "acme"}},
+		Cookies:     []*config.Parameter{{Name: "session", Value: "abc"}},
+		ContentType: "application/json",
+		BodyParams:  []*config.Parameter{{Name: "name", Value: `"alice"`}, {Name: "age", Value: "3"}},
+	}
+	testSet := &config.TestSet{URI: "http://testhost:80/api", DefaultHeaders: map[string][]string{"Lorem": {"Ipsum"}}}
+	tests := []struct {
+		name    string
+		inject  string
+		key     string
+		uri     string
+		header  string
+		cookie  string
+		body    string
+		check   string
+		encoded bool
+	}{
+		{
+			name:   "path",
+			inject: "path",
+			key:    "id",
+			uri:    "/api/users/bar!?verbose=true",
+			header: "acme",
+			cookie: "session=abc",
+			body:   `{"name":"alice","age":3}`,
+			check:  "bar!",
+		},
+		{
+			name:    "query",
+			inject:  "queryarg",
+			key:     "verbose",
+			uri:     "/api/users/1%202?verbose=bar%21",
+			header:  "acme",
+			cookie:  "session=abc",
+			body:    `{"name":"alice","age":3}`,
+			check:   "bar%21",
+			encoded: true,
+		},
+		{
+			name:   "header",
+			inject: "header",
+			key:    "X-Tenant",
+			uri:    "/api/users/1%202?verbose=true",
+			header: "bar!",
+			cookie: "session=abc",
+			body:   `{"name":"alice","age":3}`,
+			check:  "bar!",
+		},
+		{
+			name:   "body",
+			inject: "body",
+			key:    "age",
+			uri:    "/api/users/1%202?verbose=true",
+			header: "acme",
+			cookie: "session=abc",
+			body:   `{"name":"alice","age":"bar!"}`,
+			check:  "bar!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Application{TestRun: &config.TestRun{URLEncodeQuery: tt.encoded}}
+			location := &config.TestLocation{Location: "openapi", Key: tt.key, Inject: tt.inject, Request: template.Name, Template: template}
+			testRequest := &TestRequest{Payload: "bar!", TestLocation: location}
+			if err := a.buildRequest(testRequest, location, testSet); err != nil {
+				t.Fatal(err)
+			}
+			req := testRequest.Request
+			body, _ := ioutil.ReadAll(req.Body)
+			got := []string{req.Method, req.URL.RequestURI(), req.Header.Get("X-Tenant"), req.Header.Get("Cookie"), req.Header.Get("Lorem"), string(body), testRequest.CheckPayload, testRequest.locationType()}
+			want := []string{"PUT", tt.uri, tt.header, tt.cookie, "Ipsum", tt.body, tt.check, tt.inject}
+			if ok := cmp.Equal(want, got); !ok {
+				diff := cmp.Diff(want, got)
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
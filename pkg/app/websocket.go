@@ -0,0 +1,297 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+const (
+	//webSocketTimeout is how long a reply to the payload message is waited for
+	webSocketTimeout = 5 * time.Second
+	//webSocketCloseWait is how long the connection is watched for a close after a reply was received
+	webSocketCloseWait = 500 * time.Millisecond
+	//webSocketGUID is appended to the handshake key to compute the accept key (RFC 6455 section 1.3)
+	webSocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	//webSocketMaxFrame limits the size of frames read from the server
+	webSocketMaxFrame = 1 << 20
+	//closeNoStatus and closeAbnormal are the close codes reported for a close frame without a code and
+	//for a connection closed without a close frame (RFC 6455 section 7.1.5)
+	closeNoStatus = 1005
+	closeAbnormal = 1006
+)
+
+//websocket opcodes (RFC 6455 section 5.2)
+const (
+	opContinuation byte = 0x0
+	opText         byte = 0x1
+	opBinary       byte = 0x2
+	opClose        byte = 0x8
+	opPing         byte = 0x9
+	opPong         byte = 0xA
+)
+
+//webSocketHandshake builds the upgrade request sent to the websocket path of the test request
+func webSocketHandshake(req *http.Request, key string) []byte {
+	headers := req.Header.Clone()
+	headers.Del("Content-Length")
+	headers.Set("Connection", "Upgrade")
+	headers.Set("Upgrade", "websocket")
+	headers.Set("Sec-WebSocket-Key", key)
+	headers.Set("Sec-WebSocket-Version", "13")
+	return rawRequest(withHeaders(req, headers), "HTTP/1.1", nil, nil)
+}
+
+//webSocketAccept computes the Sec-WebSocket-Accept value the server must answer the handshake key with
+func webSocketAccept(key string) string {
+	sum := sha1.Sum([]byte(key + webSocketGUID))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+//webSocketFrame encodes a single frame. The payload is masked with the given key unless it is nil,
+//which sends an unmasked client frame servers are required to reject (RFC 6455 section 5.1).
+func webSocketFrame(opcode byte, fin bool, maskKey []byte, payload []byte) []byte {
+	var buf bytes.Buffer
+	b := opcode
+	if fin {
+		b |= 0x80
+	}
+	buf.WriteByte(b)
+	var mask byte
+	if maskKey != nil {
+		mask = 0x80
+	}
+	switch n := len(payload); {
+	case n < 126:
+		buf.WriteByte(mask | byte(n))
+	case n <= 0xFFFF:
+		buf.WriteByte(mask | 126)
+		binary.Write(&buf, binary.BigEndian, uint16(n))
+	default:
+		buf.WriteByte(mask | 127)
+		binary.Write(&buf, binary.BigEndian, uint64(n))
+	}
+	if maskKey == nil {
+		buf.Write(payload)
+		return buf.Bytes()
+	}
+	buf.Write(maskKey)
+	for i, c := range payload {
+		buf.WriteByte(c ^ maskKey[i%4])
+	}
+	return buf.Bytes()
+}
+
+//webSocketMask returns the masking key of a frame: random by default, all zero bytes
+//which leaves the payload readable on the wire, or nil for an unmasked frame
+func webSocketMask(mask string) ([]byte, error) {
+	switch mask {
+	case "none":
+		return nil, nil
+	case "zero":
+		return []byte{0, 0, 0, 0}, nil
+	default:
+		key := make([]byte, 4)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+}
+
+//webSocketMessage encodes the payload as a text or binary message, fragmented into frames
+//of the fragment size of the location when one is set
+func webSocketMessage(location *config.TestLocation, payload []byte) ([]byte, int, error) {
+	opcode := opText
+	if location.Frame == "binary" {
+		opcode = opBinary
+	}
+	size := location.Fragment
+	if size <= 0 || size > len(payload) {
+		size = len(payload)
+	}
+	var buf bytes.Buffer
+	frames := 0
+	for first := true; first || len(payload) > 0; first = false {
+		n := size
+		if n > len(payload) {
+			n = len(payload)
+		}
+		key, err := webSocketMask(location.Mask)
+		if err != nil {
+			return nil, 0, err
+		}
+		op := opcode
+		if !first {
+			op = opContinuation
+		}
+		buf.Write(webSocketFrame(op, n == len(payload), key, payload[:n]))
+		payload = payload[n:]
+		frames++
+	}
+	return buf.Bytes(), frames, nil
+}
+
+//readWebSocketFrame reads a single frame from the server, unmasking it if needed
+func readWebSocketFrame(r *bufio.Reader) (bool, byte, []byte, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return false, 0, nil, err
+	}
+	fin := header[0]&0x80 != 0
+	opcode := header[0] & 0x0F
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var n uint16
+		if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(n)
+	case 127:
+		if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+			return false, 0, nil, err
+		}
+	}
+	if length > webSocketMaxFrame {
+		return false, 0, nil, fmt.Errorf("websocket frame of %v bytes exceeds limit", length)
+	}
+	var maskKey []byte
+	if header[1]&0x80 != 0 {
+		maskKey = make([]byte, 4)
+		if _, err := io.ReadFull(r, maskKey); err != nil {
+			return false, 0, nil, err
+		}
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return false, 0, nil, err
+	}
+	for i := range payload {
+		if maskKey != nil {
+			payload[i] ^= maskKey[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
+
+//readWebSocketReply reads frames until the server closes the connection or stops sending. It returns
+//the close code, 0 if the connection was left open, and the first message the server sent back. The
+//reason of a close frame is used as the message when no message was received before the close.
+func readWebSocketReply(conn net.Conn, r *bufio.Reader) (int, string) {
+	var message []byte
+	received := false
+	for {
+		fin, opcode, payload, err := readWebSocketFrame(r)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				return 0, string(message)
+			}
+			return closeAbnormal, string(message)
+		}
+		switch opcode {
+		case opPing:
+			key, _ := webSocketMask("")
+			conn.Write(webSocketFrame(opPong, true, key, payload))
+		case opClose:
+			code := closeNoStatus
+			if len(payload) >= 2 {
+				code = int(binary.BigEndian.Uint16(payload))
+				if !received {
+					message = payload[2:]
+				}
+			}
+			key, _ := webSocketMask("")
+			conn.Write(webSocketFrame(opClose, true, key, nil))
+			return code, string(message)
+		case opText, opBinary, opContinuation:
+			if received {
+				continue
+			}
+			message = append(message, payload...)
+			if fin {
+				//only wait a moment for the server to close after replying
+				received = true
+				conn.SetDeadline(time.Now().Add(webSocketCloseWait))
+			}
+		}
+	}
+}
+
+//sendWebSocket performs the upgrade handshake on the websocket path of the test request and sends the
+//payload as a message. A rejected handshake is returned as the response so it is judged like any other
+//request. Otherwise the handshake response is returned and the close code and message sent back by the
+//server are recorded on the test request.
+func (t *Target) sendWebSocket(testRequest *TestRequest) (*http.Response, error) {
+	req := testRequest.Request
+	location := testRequest.TestLocation
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+	handshake := webSocketHandshake(req, key)
+	message, frames, err := webSocketMessage(location, []byte(testRequest.Payload))
+	if err != nil {
+		return nil, err
+	}
+	//keep a readable copy of the message for the report since the frames are masked
+	frame := location.Frame
+	if frame == "" {
+		frame = "text"
+	}
+	mask := location.Mask
+	if mask == "" {
+		mask = "random"
+	}
+	dump := fmt.Sprintf("%s[%s message in %d frame(s), %s mask]\r\n%s", handshake, frame, frames, mask, testRequest.Payload)
+	testRequest.RawRequest = []byte(dump)
+
+	conn, err := t.dial(req, "http/1.1")
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(t.readTimeout()))
+	if _, err := conn.Write(handshake); err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := readRawResponse(reader, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		return resp, nil
+	}
+	if resp.Header.Get("Sec-WebSocket-Accept") != webSocketAccept(key) {
+		return nil, fmt.Errorf("invalid Sec-WebSocket-Accept in handshake response")
+	}
+	conn.SetDeadline(time.Now().Add(webSocketTimeout))
+	if _, err := conn.Write(message); err != nil {
+		return nil, err
+	}
+	testRequest.CloseCode, testRequest.Message = readWebSocketReply(conn, reader)
+	return resp, nil
+}
+
+//webSocketBlocked returns true if the server closed the websocket with the close code of the
+//block condition or replied with a message containing the message of the block condition
+func webSocketBlocked(testRequest *TestRequest) bool {
+	blockCon := testRequest.BlockCon
+	if blockCon.CloseCode != 0 && testRequest.CloseCode == blockCon.CloseCode {
+		return true
+	}
+	return blockCon.Message != "" && strings.Contains(testRequest.Message, blockCon.Message)
+}
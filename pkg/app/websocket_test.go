@@ -0,0 +1,169 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/signalsciences/waf-testing-framework/pkg/config"
+)
+
+func TestWebSocketMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		location *config.TestLocation
+		want     []byte
+		frames   int
+	}{
+		{
+			name:     "unmaskedText",
+			location: &config.TestLocation{Location: "websocket", Mask: "none"},
+			want:     []byte("\x81\x04bar!"),
+			frames:   1,
+		},
+		{
+			name:     "zeroMaskBinary",
+			location: &config.TestLocation{Location: "websocket", Frame: "binary", Mask: "zero"},
+			want:     []byte("\x82\x84\x00\x00\x00\x00bar!"),
+			frames:   1,
+		},
+		{
+			name:     "fragmented",
+			location: &config.TestLocation{Location: "websocket", Fragment: 3, Mask: "none"},
+			want:     []byte("\x01\x03bar\x80\x01!"),
+			frames:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, frames, err := webSocketMessage(tt.location, []byte("bar!"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok := cmp.Equal(tt.want, got); !ok {
+				diff := cmp.Diff(tt.want, got)
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if frames != tt.frames {
+				t.Errorf("want: %v\n got: %v", tt.frames, frames)
+			}
+		})
+	}
+}
+
+func TestWebSocketFrameRoundTrip(t *testing.T) {
+	payload := bytes.Repeat([]byte("a"), 300)
+	frame := webSocketFrame(opBinary, true, []byte{1, 2, 3, 4}, payload)
+	fin, opcode, got, err := readWebSocketFrame(bufio.NewReader(bytes.NewReader(frame)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fin || opcode != opBinary || !bytes.Equal(payload, got) {
+		t.Errorf("frame not decoded: fin %v opcode %v length %v", fin, opcode, len(got))
+	}
+}
+
+func TestSendWebSocket(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	//a server that rejects handshakes outside of /ws, echoes messages and closes the connection
+	//with a policy violation when a message contains the payload
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				req, err := http.ReadRequest(reader)
+				if err != nil {
+					return
+				}
+				if req.URL.Path != "/ws" {
+					conn.Write([]byte("HTTP/1.1 403 Forbidden\r\nContent-Length: 0\r\n\r\n"))
+					return
+				}
+				conn.Write([]byte("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n" +
+					"Sec-WebSocket-Accept: " + webSocketAccept(req.Header.Get("Sec-WebSocket-Key")) + "\r\n\r\n"))
+				var message []byte
+				for {
+					fin, _, payload, err := readWebSocketFrame(reader)
+					if err != nil {
+						return
+					}
+					message = append(message, payload...)
+					if fin {
+						break
+					}
+				}
+				if bytes.Contains(message, []byte("bar!")) {
+					reason := make([]byte, 2)
+					binary.BigEndian.PutUint16(reason, 1008)
+					conn.Write(webSocketFrame(opClose, true, nil, append(reason, "blocked"...)))
+					return
+				}
+				conn.Write(webSocketFrame(opText, true, nil, message))
+				//keep the connection open until the client is done with it
+				readWebSocketFrame(reader)
+			}(conn)
+		}
+	}()
+	tests := []struct {
+		name      string
+		path      string
+		payload   string
+		location  *config.TestLocation
+		status    int
+		closeCode int
+		message   string
+	}{
+		{
+			name:      "blocked",
+			path:      "/ws",
+			payload:   "bar!",
+			location:  &config.TestLocation{Location: "websocket", Fragment: 2},
+			status:    http.StatusSwitchingProtocols,
+			closeCode: 1008,
+			message:   "blocked",
+		},
+		{
+			name:     "echoed",
+			path:     "/ws",
+			payload:  "foo",
+			location: &config.TestLocation{Location: "websocket", Frame: "binary"},
+			status:   http.StatusSwitchingProtocols,
+			message:  "foo",
+		},
+		{
+			name:     "handshakeRejected",
+			path:     "/",
+			payload:  "bar!",
+			location: &config.TestLocation{Location: "websocket"},
+			status:   http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "http://"+listener.Addr().String()+tt.path, nil)
+			testRequest := &TestRequest{Payload: tt.payload, Request: req, TestLocation: tt.location}
+			resp, err := (&Target{}).sendWebSocket(testRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.status || testRequest.CloseCode != tt.closeCode || testRequest.Message != tt.message {
+				t.Errorf("want: %v %v %q\n got: %v %v %q", tt.status, tt.closeCode, tt.message, resp.StatusCode, testRequest.CloseCode, testRequest.Message)
+			}
+		})
+	}
+}
@@ -1,18 +1,26 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
 	yaml "gopkg.in/yaml.v2"
 )
 
+//tokenChars are the characters allowed in an HTTP token such as a method (RFC 7230 section 3.2.6)
+const tokenChars = "!#$%&'*+-.^_`|~0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
 //Header is the struct that holds header kv pairs
 type Header struct {
 	Header string `yaml:"header"`
@@ -21,8 +29,11 @@ type Header struct {
 
 //Condition is the struct that holds conditions for allow/block responses
 type Condition struct {
-	Code    int       `yaml:"code"`
-	Headers []*Header `yaml:"headers"`
+	Code       int       `yaml:"code"`
+	Headers    []*Header `yaml:"headers"`
+	CloseCode  int       `yaml:"close_code" json:",omitempty"`
+	Message    string    `yaml:"message" json:",omitempty"`
+	GRPCStatus int       `yaml:"grpc_status" json:",omitempty"`
 }
 
 //FileTestBlock represents a test set from the yaml file
@@ -32,6 +43,18 @@ type FileTestBlock struct {
 	Host           string     `yaml:"host"`
 	Port           int        `yaml:"port"`
 	Path           string     `yaml:"path"`
+	HTTPVersion    string     `yaml:"http_version"`
+	OpenAPI        string     `yaml:"openapi"`
+	Login          *Login     `yaml:"login"`
+	Prefetch       *Prefetch  `yaml:"prefetch"`
+	TLS            *TLS       `yaml:"tls"`
+	Resolve        string     `yaml:"resolve"`
+	Proxy          string     `yaml:"proxy"`
+	Timeouts       *Timeouts  `yaml:"timeouts"`
+	Retry          *Retry     `yaml:"retry"`
+	RateLimit      *RateLimit `yaml:"rate_limit"`
+	RateTest       *RateTest  `yaml:"rate_test"`
+	VHosts         []string   `yaml:"vhosts"`
 	DefaultHeaders []*Header  `yaml:"default_headers"`
 	AllowCondition *Condition `yaml:"allow_condition"`
 	BlockCondition *Condition `yaml:"block_condition"`
@@ -64,11 +87,31 @@ type TestRun struct {
 
 //TestSet is the object that represents and individual testset settings
 type TestSet struct {
-	Name           string
-	URI            string
+	Name        string
+	URI         string
+	HTTPVersion string `json:",omitempty"`
+	//Resolve is the address connections are made to in place of the host of the URI
+	Resolve        string `json:",omitempty"`
 	DefaultHeaders map[string][]string
 	AllowCondition *Condition
 	BlockCondition *Condition
+	//Locations replace the locations of the test run for this set when set
+	Locations []*TestLocation `json:",omitempty"`
+	//Login holds credentials so it is left out of the report
+	Login    *Login    `json:"-"`
+	Prefetch *Prefetch `json:"-"`
+	//TLS is the client TLS configuration of https sets with tls settings
+	TLS *tls.Config `json:"-"`
+	//Proxy is the URL of the proxy connections are tunneled through, which may hold credentials
+	Proxy string `json:"-"`
+	//ConnectTimeout and ReadTimeout replace the default timeouts of test requests when set
+	ConnectTimeout time.Duration `json:",omitempty"`
+	ReadTimeout    time.Duration `json:",omitempty"`
+	Retry          *RetryPolicy  `json:",omitempty"`
+	//RateLimit replaces the rate of the -rate flag for this set when set
+	RateLimit *RateLimit `json:",omitempty"`
+	//RateTest is run in place of the payload tests in the rate test mode
+	RateTest *RateTest `json:",omitempty"`
 }
 
 //TestFile is the object that holds a file that contains tests
@@ -79,8 +122,88 @@ type TestFile struct {
 
 //TestLocation represents a single test location
 type TestLocation struct {
-	Location string `yaml:"location"`
-	Key      string `yaml:"key" json:",omitempty"`
+	Location         string   `yaml:"location"`
+	Key              string   `yaml:"key" json:",omitempty"`
+	Method           string   `yaml:"method" json:",omitempty"`
+	MethodOverride   string   `yaml:"method_override" json:",omitempty"`
+	Split            string   `yaml:"split" json:",omitempty"`
+	Padding          []string `yaml:"padding" json:"-"`
+	PaddingSize      int      `yaml:"-" json:",omitempty"`
+	TransferEncoding string   `yaml:"transfer_encoding" json:",omitempty"`
+	ChunkSize        int      `yaml:"chunk_size" json:",omitempty"`
+	ChunkExtension   string   `yaml:"chunk_extension" json:",omitempty"`
+	ContentEncoding  string   `yaml:"content_encoding" json:",omitempty"`
+	Probes           []string `yaml:"probes" json:"-"`
+	Probe            string   `yaml:"-" json:",omitempty"`
+	Frame            string   `yaml:"frame" json:",omitempty"`
+	Fragment         int      `yaml:"fragment" json:",omitempty"`
+	Mask             string   `yaml:"mask" json:",omitempty"`
+	RPC              string   `yaml:"rpc" json:",omitempty"`
+	DescriptorSet    string   `yaml:"descriptor_set" json:"-"`
+	//Message is the request message of the rpc resolved from the descriptor set
+	Message protoreflect.MessageDescriptor `yaml:"-" json:"-"`
+	//Template is the request the payload is injected into for locations built from imported requests,
+	//with Request naming it and Inject the part of the request holding the Key parameter
+	Template    *RequestTemplate `yaml:"-" json:"-"`
+	Request     string           `yaml:"-" json:",omitempty"`
+	Inject      string           `yaml:"-" json:",omitempty"`
+	File        string           `yaml:"file" json:",omitempty"`
+	Environment string           `yaml:"environment" json:",omitempty"`
+	Parameters  []string         `yaml:"parameters" json:"-"`
+	//Scenario is the scenario read from the file of a scenario location
+	Scenario *Scenario `yaml:"-" json:"-"`
+}
+
+//SmugglingProbes are the request smuggling desync probes run by the smuggling location
+var SmugglingProbes = []string{"CL.TE", "TE.CL", "TE.TE", "HTTP/1.0"}
+
+//Label returns the name the location is reported under. Locations using the default
+//request method are reported by their location alone so existing reports are unchanged.
+func (l *TestLocation) Label() string {
+	label := l.Location
+	if l.Scenario != nil {
+		label += " " + l.Scenario.Name
+	}
+	if l.Request != "" {
+		label += " " + l.Request + " " + l.Inject + ":" + l.Key
+	}
+	if l.Method != "" {
+		label += " " + l.Method
+	}
+	if l.MethodOverride != "" {
+		label += " (" + l.MethodOverride + " override)"
+	}
+	if l.Split != "" {
+		label += " [" + l.Split + "]"
+	}
+	if l.PaddingSize > 0 {
+		label += " +" + FormatSize(l.PaddingSize)
+	}
+	if l.Probe != "" {
+		label += " " + l.Probe
+	}
+	if l.TransferEncoding != "" {
+		label += " " + l.TransferEncoding
+		if l.ChunkSize > 0 {
+			label += "/" + strconv.Itoa(l.ChunkSize)
+		}
+	}
+	if l.ContentEncoding != "" {
+		label += " " + l.ContentEncoding
+	}
+	if l.RPC != "" {
+		label += " " + l.RPC
+	}
+	if l.Frame != "" {
+		label += " " + l.Frame
+	}
+	if l.Fragment > 0 {
+		label += " fragmented/" + strconv.Itoa(l.Fragment)
+	}
+	if l.Mask != "" {
+		label += " " + l.Mask + "-mask"
+	}
+	return label
 }
 
 //ParseYamlFile parses the given .yaml config into the File object
@@ -130,10 +253,104 @@ func ParseConfigs(file *File) (*TestRun, error) {
 	} else {
 		for _, l := range file.PayloadLocations {
 			location := &TestLocation{
-				Location: l.Location,
-				Key:      l.Key,
+				Location:         l.Location,
+				Key:              l.Key,
+				Method:           l.Method,
+				MethodOverride:   strings.ToLower(l.MethodOverride),
+				Split:            strings.ToLower(l.Split),
+				TransferEncoding: strings.ToLower(l.TransferEncoding),
+				ChunkSize:        l.ChunkSize,
+				ChunkExtension:   l.ChunkExtension,
+				ContentEncoding:  strings.ToLower(l.ContentEncoding),
+				Frame:            strings.ToLower(l.Frame),
+				Fragment:         l.Fragment,
+				Mask:             strings.ToLower(l.Mask),
+				RPC:              strings.TrimPrefix(l.RPC, "/"),
+				DescriptorSet:    l.DescriptorSet,
+			}
+			if err := validateMethod(location); err != nil {
+				return nil, err
+			}
+			if err := validateSplit(location); err != nil {
+				return nil, err
+			}
+			if err := validateEncoding(location); err != nil {
+				return nil, err
+			}
+			if err := validateWebSocket(location); err != nil {
+				return nil, err
+			}
+			if err := validateGRPC(location); err != nil {
+				return nil, err
+			}
+			//captured requests are run once for every parameter the payload is injected into
+			if loc := strings.ToLower(location.Location); loc == "har" || loc == "curl" || loc == "postman" {
+				location.Location = loc
+				location.File = l.File
+				location.Environment = l.Environment
+				location.Padding = l.Padding
+				location.Probes = l.Probes
+				captured, err := templateLocations(location, l.Parameters)
+				if err != nil {
+					return nil, err
+				}
+				locations = append(locations, captured...)
+				continue
+			}
+			//a scenario is run as a single location
+			if strings.ToLower(location.Location) == "scenario" {
+				if l.File == "" {
+					return nil, fmt.Errorf("location scenario requires a file")
+				}
+				if location.Method != "" {
+					return nil, fmt.Errorf("method is not supported for the scenario location")
+				}
+				scenario, err := LoadScenario(l.File)
+				if err != nil {
+					return nil, err
+				}
+				location.Location = "scenario"
+				location.File = l.File
+				location.Scenario = scenario
+				locations = append(locations, location)
+				continue
+			}
+			if l.File != "" || l.Environment != "" {
+				return nil, fmt.Errorf("file and environment are only supported for the har, curl, postman and scenario locations")
+			}
+			//the smuggling location is run once for every desync probe
+			if strings.ToLower(location.Location) == "smuggling" {
+				probes, err := smugglingProbes(l.Probes)
+				if err != nil {
+					return nil, err
+				}
+				for _, probe := range probes {
+					smuggling := *location
+					smuggling.Probe = probe
+					locations = append(locations, &smuggling)
+				}
+				continue
+			}
+			if len(l.Padding) == 0 {
+				locations = append(locations, location)
+				continue
+			}
+			//a padded location is run once for every padding size
+			if strings.ToLower(location.Location) != "body" || location.Split != "" {
+				return nil, fmt.Errorf("padding is only supported for body locations without a split")
+			}
+			for _, p := range l.Padding {
+				size, err := ParseSize(p)
+				if err != nil {
+					return nil, err
+				}
+				if wrapper := PaddingWrapper(file.PostBodyType == "json"); size < wrapper {
+					return nil, fmt.Errorf("padding %v is smaller than the %v bytes of the pad parameter", p, wrapper)
+				}
+				padded := *location
+				padded.PaddingSize = size
+				locations = append(locations, &padded)
 			}
-			locations = append(locations, location)
 		}
 	}
 	testRun.Locations = locations
@@ -181,6 +398,70 @@ func ParseConfigs(file *File) (*TestRun, error) {
 			testDef.Path = filepath.Clean(testDef.Path)
 		}
 		testDef.Path = strings.TrimLeft(testDef.Path, string(os.PathSeparator))
+		//http version
+		httpVersion, err := parseHTTPVersion(testDef.HTTPVersion, testDef.Protocol)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+		}
+		//address override
+		resolve, err := parseResolve(testDef.Resolve, testDef.Port)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+		}
+		//outbound proxy
+		if err := validateProxy(testDef.Proxy); err != nil {
+			return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+		}
+		//timeouts, retries and rate limits
+		connectTimeout, readTimeout, err := parseTimeouts(testDef.Timeouts)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+		}
+		retry, err := parseRetry(testDef.Retry)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+		}
+		if err := validateRateLimit(testDef.RateLimit); err != nil {
+			return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+		}
+		if err := validateRateTest(testDef.RateTest); err != nil {
+			return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+		}
+		//tls
+		var tlsConfig *tls.Config
+		if testDef.TLS != nil {
+			if testDef.Protocol != "https" {
+				return nil, fmt.Errorf("%v: tls requires the https protocol", testDef.Name)
+			}
+			tlsConfig, err = testDef.TLS.Config()
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+			}
+		}
+		//login flow
+		if testDef.Login != nil {
+			if err := validateLogin(testDef.Login); err != nil {
+				return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+			}
+		}
+		//prefetch step
+		if testDef.Prefetch != nil {
+			if err := validatePrefetch(testDef.Prefetch); err != nil {
+				return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+			}
+		}
+		//locations of the operations of an OpenAPI document
+		var setLocations []*TestLocation
+		if testDef.OpenAPI != "" {
+			templates, err := LoadOpenAPI(testDef.OpenAPI)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", testDef.Name, err)
+			}
+			for _, t := range templates {
+				setLocations = append(setLocations, t.Locations("openapi", func(string, string) bool { return true })...)
+			}
+			setLocations = uniqueLocations(setLocations)
+		}
 		//headers
 		headers := map[string][]string{
 			"Accept":          {"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"},
@@ -214,8 +495,11 @@ func ParseConfigs(file *File) (*TestRun, error) {
 			}
 		}
 		blockConditon := &Condition{
-			Code:    testDef.BlockCondition.Code,
-			Headers: blockHeaders,
+			Code:       testDef.BlockCondition.Code,
+			Headers:    blockHeaders,
+			CloseCode:  testDef.BlockCondition.CloseCode,
+			Message:    testDef.BlockCondition.Message,
+			GRPCStatus: testDef.BlockCondition.GRPCStatus,
 		}
 		//allow test conditions
 		var allowHeaders []*Header
@@ -237,15 +521,264 @@ func ParseConfigs(file *File) (*TestRun, error) {
 		testSet := &TestSet{
 			Name:           testDef.Name,
 			URI:            fmt.Sprintf("%s://%s:%s/%s", testDef.Protocol, testDef.Host, strconv.Itoa(testDef.Port), testDef.Path),
+			HTTPVersion:    httpVersion,
+			Resolve:        resolve,
 			DefaultHeaders: headers,
 			AllowCondition: allowConditon,
 			BlockCondition: blockConditon,
+			Locations:      setLocations,
+			Login:          testDef.Login,
+			Prefetch:       testDef.Prefetch,
+			TLS:            tlsConfig,
+			Proxy:          testDef.Proxy,
+			ConnectTimeout: connectTimeout,
+			ReadTimeout:    readTimeout,
+			Retry:          retry,
+			RateLimit:      testDef.RateLimit,
+			RateTest:       testDef.RateTest,
+		}
+		if len(testDef.VHosts) == 0 {
+			testRun.TestSets = append(testRun.TestSets, testSet)
+			continue
+		}
+		//every virtual host is tested as a set of its own, connecting to the WAF host
+		if resolve == "" {
+			resolve = net.JoinHostPort(testDef.Host, strconv.Itoa(testDef.Port))
+		}
+		for _, vhost := range testDef.VHosts {
+			vhostSet := *testSet
+			vhostSet.Name = fmt.Sprintf("%s (%s)", testDef.Name, vhost)
+			vhostSet.URI = fmt.Sprintf("%s://%s:%s/%s", testDef.Protocol, vhost, strconv.Itoa(testDef.Port), testDef.Path)
+			vhostSet.Resolve = resolve
+			testRun.TestSets = append(testRun.TestSets, &vhostSet)
 		}
-		testRun.TestSets = append(testRun.TestSets, testSet)
 	}
 	return &testRun, nil
 }
 
+//parseResolve returns the address of a resolve override, which is an IP address or host name with
+//an optional port defaulting to the port of the WAF
+func parseResolve(resolve string, port int) (string, error) {
+	if resolve == "" {
+		return "", nil
+	}
+	if host, p, err := net.SplitHostPort(resolve); err == nil {
+		if _, err := strconv.Atoi(p); err != nil || host == "" {
+			return "", fmt.Errorf("invalid resolve %q", resolve)
+		}
+		return resolve, nil
+	}
+	return net.JoinHostPort(strings.Trim(resolve, "[]"), strconv.Itoa(port)), nil
+}
+
+//validateProxy checks a proxy is the URL of an HTTP proxy, tunneling connections with CONNECT, or of a
+//SOCKS5 proxy, with optional credentials
+func validateProxy(proxy string) error {
+	if proxy == "" {
+		return nil
+	}
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return fmt.Errorf("invalid proxy: %v", err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return fmt.Errorf("unsupported proxy scheme %q, must be http, https, socks5 or socks5h", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("proxy URL has no host")
+	}
+	return nil
+}
+
+//validateMethod checks that the request method of a location is a valid HTTP token. Methods are
+//case-sensitive, so the case of the method is kept.
+//and that a method override is only requested alongside a method to override to
+func validateMethod(location *TestLocation) error {
+	if location.Method != "" && strings.IndexFunc(location.Method, func(r rune) bool {
+		return !strings.ContainsRune(tokenChars, r)
+	}) != -1 {
+		return fmt.Errorf("invalid method %q for location %v", location.Method, location.Location)
+	}
+	switch location.MethodOverride {
+	case "":
+		return nil
+	case "header", "query", "body":
+		if location.Method == "" {
+			return fmt.Errorf("method_override for location %v requires a method", location.Location)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown method_override %q for location %v", location.MethodOverride, location.Location)
+	}
+}
+
+//validateSplit checks that a parameter pollution strategy is known and supported by the location.
+//duplicate sends a benign value followed by the payload, split sends the payload in two halves,
+//and mixed sends the first half in the query and the second half in the body.
+func validateSplit(location *TestLocation) error {
+	loc := strings.ToLower(location.Location)
+	switch location.Split {
+	case "":
+		return nil
+	case "duplicate", "split":
+		if loc == "queryarg" || loc == "body" || loc == "cookie" || loc == "header" {
+			return nil
+		}
+	case "mixed":
+		if loc == "queryarg" || loc == "body" {
+			return nil
+		}
+	default:
+		return fmt.Errorf("unknown split %q for location %v", location.Split, location.Location)
+	}
+	return fmt.Errorf("split %q is not supported for location %v", location.Split, location.Location)
+}
+
+//validateEncoding checks the transfer and content encodings of a location are known and
+//only used on locations that send the payload in the body
+func validateEncoding(location *TestLocation) error {
+	if location.TransferEncoding == "" && (location.ChunkSize != 0 || location.ChunkExtension != "") {
+		return fmt.Errorf("chunk_size and chunk_extension require transfer_encoding chunked")
+	}
+	if location.TransferEncoding == "" && location.ContentEncoding == "" {
+		return nil
+	}
+	if loc := strings.ToLower(location.Location); loc != "body" && loc != "body_name" {
+		return fmt.Errorf("transfer_encoding and content_encoding are only supported for body locations")
+	}
+	if location.TransferEncoding != "" && location.TransferEncoding != "chunked" {
+		return fmt.Errorf("unknown transfer_encoding %q for location %v", location.TransferEncoding, location.Location)
+	}
+	if location.ChunkSize < 0 {
+		return fmt.Errorf("invalid chunk_size %v for location %v", location.ChunkSize, location.Location)
+	}
+	switch location.ContentEncoding {
+	case "", "gzip", "deflate", "br":
+		return nil
+	default:
+		return fmt.Errorf("unknown content_encoding %q for location %v", location.ContentEncoding, location.Location)
+	}
+}
+
+//validateWebSocket checks the frame options of a location are known and only used on the websocket location.
+//The handshake is always a GET request so the request method can not be changed.
+func validateWebSocket(location *TestLocation) error {
+	if strings.ToLower(location.Location) != "websocket" {
+		if location.Frame != "" || location.Fragment != 0 || location.Mask != "" {
+			return fmt.Errorf("frame, fragment and mask are only supported for the websocket location")
+		}
+		return nil
+	}
+	if location.Method != "" {
+		return fmt.Errorf("method is not supported for the websocket location")
+	}
+	switch location.Frame {
+	case "", "text", "binary":
+	default:
+		return fmt.Errorf("unknown frame %q for location %v", location.Frame, location.Location)
+	}
+	if location.Fragment < 0 {
+		return fmt.Errorf("invalid fragment %v for location %v", location.Fragment, location.Location)
+	}
+	switch location.Mask {
+	case "", "random", "zero", "none":
+		return nil
+	default:
+		return fmt.Errorf("unknown mask %q for location %v", location.Mask, location.Location)
+	}
+}
+
+//smugglingProbes normalizes the configured probe names, defaulting to all probes
+func smugglingProbes(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return SmugglingProbes, nil
+	}
+	var probes []string
+	for _, name := range names {
+		probe := strings.ToUpper(strings.TrimSpace(name))
+		if probe == "HTTP1.0" {
+			probe = "HTTP/1.0"
+		}
+		found := false
+		for _, p := range SmugglingProbes {
+			if p == probe {
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown smuggling probe: %v", name)
+		}
+		probes = append(probes, probe)
+	}
+	return probes, nil
+}
+
+//parseHTTPVersion normalizes the HTTP version of a test set. HTTP/2 is negotiated over TLS
+//while h2c speaks cleartext HTTP/2 with prior knowledge.
+func parseHTTPVersion(version string, protocol string) (string, error) {
+	v := strings.ToLower(strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(version)), "HTTP/"))
+	switch v {
+	case "", "1.0", "1.1":
+		return v, nil
+	case "2", "2.0":
+		if protocol != "https" {
+			return "", fmt.Errorf("http_version 2 requires the https protocol, use h2c for cleartext HTTP/2")
+		}
+		return "2", nil
+	case "h2c":
+		if protocol != "http" {
+			return "", fmt.Errorf("http_version h2c requires the http protocol")
+		}
+		return v, nil
+	default:
+		return "", fmt.Errorf("unknown http_version: %v", version)
+	}
+}
+
+//ParseSize parses a byte size such as 512, 8KB or 1MB. Units are multiples of 1024.
+func ParseSize(size string) (int, error) {
+	s := strings.ToUpper(strings.TrimSpace(size))
+	multiplier := 1
+	for _, unit := range []struct {
+		suffix string
+		value  int
+	}{{"KB", 1 << 10}, {"MB", 1 << 20}, {"K", 1 << 10}, {"M", 1 << 20}, {"B", 1}} {
+		if strings.HasSuffix(s, unit.suffix) {
+			s = strings.TrimSpace(strings.TrimSuffix(s, unit.suffix))
+			multiplier = unit.value
+			break
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size: %v", size)
+	}
+	return n * multiplier, nil
+}
+
+//PaddingWrapper returns the size of the pad parameter holding the padding of a body, the
+//{"pad":"", of JSON bodies or the pad=& of the others, which is the smallest padding size
+func PaddingWrapper(isJSON bool) int {
+	if isJSON {
+		return len(`{"pad":"",`)
+	}
+	return len("pad=&")
+}
+
+//FormatSize formats a byte size using the largest unit that divides it evenly
+func FormatSize(size int) string {
+	switch {
+	case size >= 1<<20 && size%(1<<20) == 0:
+		return strconv.Itoa(size>>20) + "MB"
+	case size >= 1<<10 && size%(1<<10) == 0:
+		return strconv.Itoa(size>>10) + "KB"
+	default:
+		return strconv.Itoa(size) + "B"
+	}
+}
+
 // walkFiles starts a goroutine to walk the directory tree at root and send the
 // path of each regular file on the string channel.  It sends the result of the
 // walk on the error channel.  If done is closed, walkFiles abandons its work.
@@ -0,0 +1,220 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+//curlArgOptions are the curl options taking an argument that do not describe the request
+var curlArgOptions = map[string]bool{
+	"-o": true, "--output": true, "-m": true, "--max-time": true, "--connect-timeout": true,
+	"-x": true, "--proxy": true, "--resolve": true, "-w": true, "--write-out": true,
+	"--cacert": true, "-E": true, "--cert": true, "--key": true, "--retry": true,
+}
+
+//LoadCurl reads a file of curl commands, such as those copied from the network panel of a browser,
+//and returns a request template for every command. Commands may span lines ending with a backslash.
+func LoadCurl(path string) ([]*RequestTemplate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	text := strings.NewReplacer("\\\r\n", " ", "\\\n", " ").Replace(string(data))
+	var templates []*RequestTemplate
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		args, err := shellWords(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid curl command in %v: %v", path, err)
+		}
+		template, err := curlTemplate(args)
+		if err != nil {
+			return nil, fmt.Errorf("invalid curl command in %v: %v", path, err)
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+//curlTemplate builds the template of the request a curl command sends
+func curlTemplate(args []string) (*RequestTemplate, error) {
+	if len(args) == 0 || args[0] != "curl" {
+		return nil, fmt.Errorf("command does not start with curl")
+	}
+	var method, rawURL string
+	var headers []*Parameter
+	var data []string
+	get := false
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		//options with an attached value such as -XPOST or --data=a
+		value := ""
+		hasValue := false
+		if strings.HasPrefix(arg, "--") && strings.Contains(arg, "=") {
+			kv := strings.SplitN(arg, "=", 2)
+			arg, value, hasValue = kv[0], kv[1], true
+		} else if len(arg) > 2 && arg[0] == '-' && arg[1] != '-' && strings.ContainsAny(arg[1:2], "XHdbAeu") {
+			arg, value, hasValue = arg[:2], arg[2:], true
+		}
+		next := func() (string, error) {
+			if hasValue {
+				return value, nil
+			}
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("option %v requires a value", arg)
+			}
+			i++
+			return args[i], nil
+		}
+		switch arg {
+		case "-X", "--request", "-H", "--header", "-d", "--data", "--data-raw", "--data-binary", "--data-ascii",
+			"--data-urlencode", "-b", "--cookie", "-A", "--user-agent", "-e", "--referer", "-u", "--user", "--url":
+			v, err := next()
+			if err != nil {
+				return nil, err
+			}
+			switch arg {
+			case "-X", "--request":
+				method = v
+			case "-H", "--header":
+				kv := strings.SplitN(v, ":", 2)
+				if len(kv) == 2 {
+					headers = append(headers, &Parameter{Name: strings.TrimSpace(kv[0]), Value: strings.TrimSpace(kv[1])})
+				}
+			case "-b", "--cookie":
+				headers = append(headers, &Parameter{Name: "Cookie", Value: v})
+			case "-A", "--user-agent":
+				headers = append(headers, &Parameter{Name: "User-Agent", Value: v})
+			case "-e", "--referer":
+				headers = append(headers, &Parameter{Name: "Referer", Value: v})
+			case "-u", "--user":
+				headers = append(headers, &Parameter{Name: "Authorization", Value: "Basic " + base64.StdEncoding.EncodeToString([]byte(v))})
+			case "--url":
+				rawURL = v
+			default:
+				data = append(data, v)
+			}
+		case "-G", "--get":
+			get = true
+		default:
+			if curlArgOptions[arg] && !hasValue {
+				i++
+				continue
+			}
+			if !strings.HasPrefix(arg, "-") && rawURL == "" {
+				rawURL = arg
+			}
+		}
+	}
+	if rawURL == "" {
+		return nil, fmt.Errorf("no URL in curl command")
+	}
+	body := strings.Join(data, "&")
+	//-G sends the data in the query
+	if get && body != "" {
+		if strings.Contains(rawURL, "?") {
+			rawURL += "&" + body
+		} else {
+			rawURL += "?" + body
+		}
+		body = ""
+	}
+	if method == "" {
+		method = http.MethodGet
+		if body != "" {
+			method = http.MethodPost
+		}
+	}
+	contentType := ""
+	if body != "" {
+		contentType = "application/x-www-form-urlencoded"
+		for _, h := range headers {
+			if strings.EqualFold(h.Name, "Content-Type") {
+				contentType = h.Value
+			}
+		}
+	}
+	return capturedTemplate(method, rawURL, headers, contentType, body)
+}
+
+//shellWords splits a command line into words following the quoting rules of a POSIX shell:
+//single quotes are literal, double quotes and unquoted text allow backslash escapes. The $'...'
+//quoting used by browsers when copying requests as curl is also understood.
+func shellWords(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == ' ' || c == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case c == '\'' || (c == '$' && i+1 < len(line) && line[i+1] == '\''):
+			ansi := c == '$'
+			if ansi {
+				i++
+			}
+			inWord = true
+			end := i + 1
+			for ; end < len(line) && line[end] != '\''; end++ {
+				if ansi && line[end] == '\\' && end+1 < len(line) {
+					end++
+					word.WriteString(ansiEscape(line[end]))
+					continue
+				}
+				word.WriteByte(line[end])
+			}
+			if end >= len(line) {
+				return nil, fmt.Errorf("unterminated quote")
+			}
+			i = end
+		case c == '"':
+			inWord = true
+			end := i + 1
+			for ; end < len(line) && line[end] != '"'; end++ {
+				if line[end] == '\\' && end+1 < len(line) && strings.IndexByte("\"\\$`", line[end+1]) >= 0 {
+					end++
+				}
+				word.WriteByte(line[end])
+			}
+			if end >= len(line) {
+				return nil, fmt.Errorf("unterminated quote")
+			}
+			i = end
+		case c == '\\' && i+1 < len(line):
+			inWord = true
+			i++
+			word.WriteByte(line[i])
+		default:
+			inWord = true
+			word.WriteByte(c)
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+//ansiEscape returns the character of a backslash escape in $'...' quoting
+func ansiEscape(c byte) string {
+	switch c {
+	case 'n':
+		return "\n"
+	case 'r':
+		return "\r"
+	case 't':
+		return "\t"
+	}
+	return string(c)
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var curlCommands = `# captured from the network panel
+curl 'https://app.example.com/search?q=shoes' \
+  -H 'Accept: text/html' \
+  -H 'Cookie: session=abc' \
+  --compressed
+
+curl -X PUT https://app.example.com/api/profile -H "Content-Type: application/json" --data-raw $'{"name":"it\'s me"}'
+curl https://app.example.com/login -d user=alice -d 'pass=p%26ss' -o /dev/null
+curl -G https://app.example.com/list --data-urlencode "sort=name" -u admin:secret
+`
+
+func TestLoadCurl(t *testing.T) {
+	f, err := ioutil.TempFile("", "curl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(curlCommands)
+	f.Close()
+	want := []*RequestTemplate{
+		{
+			Name:    "GET /search",
+			Method:  "GET",
+			Path:    "/search",
+			Query:   []*Parameter{{Name: "q", Value: "shoes"}},
+			Headers: []*Parameter{{Name: "Accept", Value: "text/html"}},
+			Cookies: []*Parameter{{Name: "session", Value: "abc"}},
+		},
+		{
+			Name:        "PUT /api/profile",
+			Method:      "PUT",
+			Path:        "/api/profile",
+			ContentType: "application/json",
+			BodyParams:  []*Parameter{{Name: "name", Value: `"it's me"`}},
+		},
+		{
+			Name:        "POST /login",
+			Method:      "POST",
+			Path:        "/login",
+			ContentType: "application/x-www-form-urlencoded",
+			BodyParams:  []*Parameter{{Name: "user", Value: "alice"}, {Name: "pass", Value: "p&ss"}},
+		},
+		{
+			Name:    "GET /list",
+			Method:  "GET",
+			Path:    "/list",
+			Query:   []*Parameter{{Name: "sort", Value: "name"}},
+			Headers: []*Parameter{{Name: "Authorization", Value: "Basic YWRtaW46c2VjcmV0"}},
+		},
+	}
+	got, err := LoadCurl(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok := cmp.Equal(want, got); !ok {
+		diff := cmp.Diff(want, got)
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestShellWords(t *testing.T) {
+	var tests = []struct {
+		line string
+		want []string
+		err  bool
+	}{
+		{line: `curl  -H 'a: b'`, want: []string{"curl", "-H", "a: b"}},
+		{line: `curl -d "x=\"y\"" a\ b`, want: []string{"curl", "-d", `x="y"`, "a b"}},
+		{line: `curl $'a\tb'`, want: []string{"curl", "a\tb"}},
+		{line: `curl 'open`, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			got, err := shellWords(tt.line)
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v", err)
+			}
+			if ok := cmp.Equal(tt.want, got); !ok {
+				diff := cmp.Diff(tt.want, got)
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+//validateGRPC checks a gRPC location names an rpc found in its descriptor set and resolves the request
+//message of the rpc. The key, when given, must be the dotted path of a string field of the message.
+func validateGRPC(location *TestLocation) error {
+	loc := strings.ToLower(location.Location)
+	if loc != "grpc" && loc != "grpc_web" {
+		if location.RPC != "" || location.DescriptorSet != "" {
+			return fmt.Errorf("rpc and descriptor_set are only supported for the grpc and grpc_web locations")
+		}
+		return nil
+	}
+	if location.Method != "" {
+		return fmt.Errorf("method is not supported for location %v", location.Location)
+	}
+	if location.RPC == "" || location.DescriptorSet == "" {
+		return fmt.Errorf("location %v requires an rpc and a descriptor_set", location.Location)
+	}
+	message, err := loadRPC(location.DescriptorSet, location.RPC)
+	if err != nil {
+		return err
+	}
+	if location.Key != "" {
+		if _, err := StringField(message, location.Key); err != nil {
+			return err
+		}
+	}
+	location.Message = message
+	return nil
+}
+
+//loadRPC reads a binary FileDescriptorSet, as written by protoc --descriptor_set_out --include_imports,
+//and returns the request message of the rpc named package.Service/Method
+func loadRPC(path string, rpc string) (protoreflect.MessageDescriptor, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid descriptor set %v: %v", path, err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, fmt.Errorf("invalid descriptor set %v: %v", path, err)
+	}
+	parts := strings.Split(strings.TrimPrefix(rpc, "/"), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("rpc %q must be of the form package.Service/Method", rpc)
+	}
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(parts[0]))
+	if err != nil {
+		return nil, fmt.Errorf("service %v not found in %v", parts[0], path)
+	}
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a service", parts[0])
+	}
+	method := service.Methods().ByName(protoreflect.Name(parts[1]))
+	if method == nil {
+		return nil, fmt.Errorf("method %v not found in service %v", parts[1], parts[0])
+	}
+	return method.Input(), nil
+}
+
+//StringField resolves the dotted path of a string field of the message, such as user.name,
+//returning the descriptors of every field along the path
+func StringField(message protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	var fields []protoreflect.FieldDescriptor
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		field := message.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("field %v not found in message %v", name, message.FullName())
+		}
+		fields = append(fields, field)
+		if i == len(names)-1 {
+			if field.Kind() != protoreflect.StringKind {
+				return nil, fmt.Errorf("field %v of message %v is not a string", name, message.FullName())
+			}
+			break
+		}
+		if field.Message() == nil || field.IsMap() {
+			return nil, fmt.Errorf("field %v of message %v is not a message", name, message.FullName())
+		}
+		message = field.Message()
+	}
+	return fields, nil
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+//writeDescriptorSet writes the descriptor set of a greeter service to a temporary file
+func writeDescriptorSet(t *testing.T) string {
+	stringField := func(name string, number int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		}
+	}
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("greeter.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name:  proto.String("User"),
+				Field: []*descriptorpb.FieldDescriptorProto{stringField("name", 1)},
+			},
+			{
+				Name: proto.String("HelloRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					stringField("greeting", 1),
+					{
+						Name:     proto.String("user"),
+						Number:   proto.Int32(2),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+						TypeName: proto.String(".test.User"),
+					},
+					{
+						Name:   proto.String("count"),
+						Number: proto.Int32(3),
+						Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:   descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+					},
+				},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("Greeter"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{
+						Name:       proto.String("SayHello"),
+						InputType:  proto.String(".test.HelloRequest"),
+						OutputType: proto.String(".test.User"),
+					},
+				},
+			},
+		},
+	}
+	data, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := ioutil.TempFile("", "descriptor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func TestValidateGRPC(t *testing.T) {
+	descriptorSet := writeDescriptorSet(t)
+	defer os.Remove(descriptorSet)
+	tests := []struct {
+		name     string
+		location *TestLocation
+		wantErr  bool
+	}{
+		{name: "allStrings", location: &TestLocation{Location: "grpc", RPC: "test.Greeter/SayHello", DescriptorSet: descriptorSet}},
+		{name: "nestedField", location: &TestLocation{Location: "grpc_web", Key: "user.name", RPC: "test.Greeter/SayHello", DescriptorSet: descriptorSet}},
+		{name: "notString", location: &TestLocation{Location: "grpc", Key: "count", RPC: "test.Greeter/SayHello", DescriptorSet: descriptorSet}, wantErr: true},
+		{name: "unknownField", location: &TestLocation{Location: "grpc", Key: "user.email", RPC: "test.Greeter/SayHello", DescriptorSet: descriptorSet}, wantErr: true},
+		{name: "unknownService", location: &TestLocation{Location: "grpc", RPC: "test.Farewell/SayBye", DescriptorSet: descriptorSet}, wantErr: true},
+		{name: "unknownMethod", location: &TestLocation{Location: "grpc", RPC: "test.Greeter/SayBye", DescriptorSet: descriptorSet}, wantErr: true},
+		{name: "invalidRPC", location: &TestLocation{Location: "grpc", RPC: "SayHello", DescriptorSet: descriptorSet}, wantErr: true},
+		{name: "missingDescriptorSet", location: &TestLocation{Location: "grpc", RPC: "test.Greeter/SayHello"}, wantErr: true},
+		{name: "otherLocation", location: &TestLocation{Location: "body", RPC: "test.Greeter/SayHello"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGRPC(tt.location)
+			if err != nil && !tt.wantErr {
+				t.Errorf("%v", err)
+			}
+			if err == nil && tt.wantErr {
+				t.Errorf("no expected error")
+			}
+			if err == nil && tt.location.Message.FullName() != "test.HelloRequest" {
+				t.Errorf("want: test.HelloRequest\n got: %v", tt.location.Message.FullName())
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+)
+
+//harFile holds the parts of a HAR 1.2 archive needed to build request templates
+type harFile struct {
+	Log struct {
+		Entries []struct {
+			Request struct {
+				Method   string       `json:"method"`
+				URL      string       `json:"url"`
+				Headers  []*Parameter `json:"headers"`
+				PostData *struct {
+					MimeType string       `json:"mimeType"`
+					Text     string       `json:"text"`
+					Params   []*Parameter `json:"params"`
+				} `json:"postData"`
+			} `json:"request"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+//LoadHAR reads a HAR archive exported from a browser or proxy and returns a request template
+//for every captured request
+func LoadHAR(path string) ([]*RequestTemplate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var har harFile
+	if err := json.Unmarshal(data, &har); err != nil {
+		return nil, fmt.Errorf("invalid HAR file %v: %v", path, err)
+	}
+	var templates []*RequestTemplate
+	for _, entry := range har.Log.Entries {
+		req := entry.Request
+		var contentType, body string
+		if req.PostData != nil {
+			contentType = req.PostData.MimeType
+			body = req.PostData.Text
+			//form bodies may only be given as params
+			if body == "" && len(req.PostData.Params) > 0 {
+				var fields []string
+				for _, p := range req.PostData.Params {
+					fields = append(fields, url.QueryEscape(p.Name)+"="+url.QueryEscape(p.Value))
+				}
+				body = strings.Join(fields, "&")
+			}
+		}
+		template, err := capturedTemplate(req.Method, req.URL, req.Headers, contentType, body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request in HAR file %v: %v", path, err)
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
@@ -0,0 +1,153 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var harArchive = `{
+  "log": {
+    "version": "1.2",
+    "entries": [
+      {
+        "request": {
+          "method": "GET",
+          "url": "https://app.example.com/search?q=shoes&page=2",
+          "headers": [
+            {"name": ":authority", "value": "app.example.com"},
+            {"name": "Host", "value": "app.example.com"},
+            {"name": "Cookie", "value": "session=abc; theme=dark"},
+            {"name": "X-Requested-With", "value": "XMLHttpRequest"}
+          ]
+        }
+      },
+      {
+        "request": {
+          "method": "POST",
+          "url": "https://app.example.com/login",
+          "headers": [{"name": "Content-Length", "value": "27"}],
+          "postData": {
+            "mimeType": "application/x-www-form-urlencoded",
+            "params": [{"name": "user", "value": "alice"}, {"name": "pass", "value": "p&ss"}]
+          }
+        }
+      },
+      {
+        "request": {
+          "method": "PUT",
+          "url": "https://app.example.com/api/profile",
+          "headers": [],
+          "postData": {
+            "mimeType": "application/json; charset=utf-8",
+            "text": "{\"name\": \"alice\", \"age\": 30}"
+          }
+        }
+      }
+    ]
+  }
+}`
+
+func TestLoadHAR(t *testing.T) {
+	f, err := ioutil.TempFile("", "har")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(harArchive)
+	f.Close()
+	want := []*RequestTemplate{
+		{
+			Name:    "GET /search",
+			Method:  "GET",
+			Path:    "/search",
+			Query:   []*Parameter{{Name: "q", Value: "shoes"}, {Name: "page", Value: "2"}},
+			Headers: []*Parameter{{Name: "X-Requested-With", Value: "XMLHttpRequest"}},
+			Cookies: []*Parameter{{Name: "session", Value: "abc"}, {Name: "theme", Value: "dark"}},
+		},
+		{
+			Name:        "POST /login",
+			Method:      "POST",
+			Path:        "/login",
+			ContentType: "application/x-www-form-urlencoded",
+			BodyParams:  []*Parameter{{Name: "user", Value: "alice"}, {Name: "pass", Value: "p&ss"}},
+		},
+		{
+			Name:        "PUT /api/profile",
+			Method:      "PUT",
+			Path:        "/api/profile",
+			ContentType: "application/json; charset=utf-8",
+			BodyParams:  []*Parameter{{Name: "age", Value: "30"}, {Name: "name", Value: `"alice"`}},
+		},
+	}
+	got, err := LoadHAR(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok := cmp.Equal(want, got); !ok {
+		diff := cmp.Diff(want, got)
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTemplateLocations(t *testing.T) {
+	f, err := ioutil.TempFile("", "har")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(harArchive)
+	f.Close()
+	var tests = []struct {
+		name       string
+		parameters []string
+		want       []string
+		err        bool
+	}{
+		{
+			name: "default",
+			want: []string{
+				"har GET /search queryarg:q",
+				"har GET /search queryarg:page",
+				"har GET /search cookie:session",
+				"har GET /search cookie:theme",
+				"har POST /login body:user",
+				"har POST /login body:pass",
+				"har PUT /api/profile body:age",
+				"har PUT /api/profile body:name",
+			},
+		},
+		{
+			name:       "chosen",
+			parameters: []string{"q", "header:X-Requested-With", "body:name"},
+			want: []string{
+				"har GET /search queryarg:q",
+				"har GET /search header:X-Requested-With",
+				"har PUT /api/profile body:name",
+			},
+		},
+		{
+			name:       "none",
+			parameters: []string{"missing"},
+			err:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locations, err := templateLocations(&TestLocation{Location: "har", File: f.Name()}, tt.parameters)
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v", err)
+			}
+			var got []string
+			for _, l := range locations {
+				got = append(got, l.Label())
+			}
+			if ok := cmp.Equal(tt.want, got); !ok {
+				diff := cmp.Diff(tt.want, got)
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
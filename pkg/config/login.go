@@ -0,0 +1,118 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+//Login is the flow run before the tests of a WAF to open an authenticated session. A form login posts
+//credentials and keeps the session cookies, a bearer login fetches a token sent in a header.
+type Login struct {
+	Type    string            `yaml:"type"`
+	URL     string            `yaml:"url"`
+	Method  string            `yaml:"method"`
+	Headers []*Header         `yaml:"headers"`
+	Form    map[string]string `yaml:"form"`
+	Body    string            `yaml:"body"`
+	//Token is extracted from the login response and sent in TokenHeader prefixed by TokenPrefix
+	Token       *Extract `yaml:"token"`
+	TokenHeader string   `yaml:"token_header"`
+	TokenPrefix string   `yaml:"token_prefix"`
+	//Expired is the response showing the session expired, after which the login is run again
+	Expired *Condition `yaml:"expired"`
+}
+
+//Extract describes how a value is taken from a response: the first capture group, or the whole
+//match, of Regex applied to the body, the value at the dotted JSONPath of a JSON body (ex: data.token
+//or items.0.id), the value of the response Header, or the Attribute or text of the first element of
+//an HTML body matching the CSS Selector (ex: form#login input[name=csrf])
+type Extract struct {
+	Regex     string `yaml:"regex"`
+	JSONPath  string `yaml:"json_path"`
+	Header    string `yaml:"header"`
+	Selector  string `yaml:"selector"`
+	Attribute string `yaml:"attribute"`
+	//Pattern is the compiled Regex
+	Pattern *regexp.Regexp `yaml:"-"`
+}
+
+//validateLogin checks the login flow of a WAF and fills in its defaults. Login URLs starting with
+//a slash are paths on the WAF, sent to the host of each test set of the WAF.
+func validateLogin(login *Login) error {
+	login.Type = strings.ToLower(login.Type)
+	switch login.Type {
+	case "form":
+	case "bearer":
+		if login.Token == nil {
+			return fmt.Errorf("bearer login requires a token")
+		}
+		if login.TokenPrefix == "" {
+			login.TokenPrefix = "Bearer "
+		}
+	default:
+		return fmt.Errorf("unknown login type %q", login.Type)
+	}
+	if login.URL == "" {
+		return fmt.Errorf("login requires a url")
+	}
+	if u, err := url.Parse(login.URL); err != nil || (u.Host == "" && !strings.HasPrefix(login.URL, "/")) {
+		return fmt.Errorf("invalid login url %q", login.URL)
+	}
+	login.Method = strings.ToUpper(login.Method)
+	if login.Method == "" {
+		login.Method = http.MethodPost
+	}
+	if len(login.Form) > 0 && login.Body != "" {
+		return fmt.Errorf("login form and body can not both be set")
+	}
+	for _, h := range login.Headers {
+		h.Header = http.CanonicalHeaderKey(h.Header)
+	}
+	if login.Token != nil {
+		if err := validateExtract(login.Token); err != nil {
+			return fmt.Errorf("login token: %v", err)
+		}
+		if login.TokenHeader == "" {
+			login.TokenHeader = "Authorization"
+		}
+		login.TokenHeader = http.CanonicalHeaderKey(login.TokenHeader)
+	}
+	if login.Expired != nil {
+		if login.Expired.Code == 0 && len(login.Expired.Headers) == 0 {
+			return fmt.Errorf("login expired condition requires a code or headers")
+		}
+		for _, h := range login.Expired.Headers {
+			h.Header = http.CanonicalHeaderKey(h.Header)
+		}
+	}
+	return nil
+}
+
+//validateExtract checks exactly one source is given for the extracted value and compiles its regex
+func validateExtract(extract *Extract) error {
+	sources := 0
+	for _, s := range []string{extract.Regex, extract.JSONPath, extract.Header, extract.Selector} {
+		if s != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("exactly one of regex, json_path, header and selector is required")
+	}
+	if extract.Attribute != "" && extract.Selector == "" {
+		return fmt.Errorf("attribute requires a selector")
+	}
+	if extract.Regex != "" {
+		pattern, err := regexp.Compile(extract.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex %q: %v", extract.Regex, err)
+		}
+		extract.Pattern = pattern
+	}
+	extract.JSONPath = strings.TrimPrefix(strings.TrimPrefix(extract.JSONPath, "$"), ".")
+	extract.Header = http.CanonicalHeaderKey(extract.Header)
+	return nil
+}
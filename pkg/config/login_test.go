@@ -0,0 +1,82 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestValidateLogin(t *testing.T) {
+	tests := []struct {
+		name   string
+		login  *Login
+		url    string
+		header string
+		prefix string
+		err    bool
+	}{
+		{
+			name:  "relativeForm",
+			login: &Login{Type: "Form", URL: "/login", Form: map[string]string{"user": "alice"}},
+			url:   "/login",
+		},
+		{
+			name:   "bearer",
+			login:  &Login{Type: "bearer", URL: "http://localhost:9000/token", Token: &Extract{JSONPath: "$.access_token"}},
+			url:    "http://localhost:9000/token",
+			header: "Authorization",
+			prefix: "Bearer ",
+		},
+		{
+			name:   "customHeader",
+			login:  &Login{Type: "form", URL: "/login", Token: &Extract{Regex: `token=(\w+)`}, TokenHeader: "x-api-key"},
+			url:    "/login",
+			header: "X-Api-Key",
+		},
+		{
+			name:  "bearerWithoutToken",
+			login: &Login{Type: "bearer", URL: "/token"},
+			err:   true,
+		},
+		{
+			name:  "unknownType",
+			login: &Login{Type: "oauth", URL: "/token"},
+			err:   true,
+		},
+		{
+			name:  "missingURL",
+			login: &Login{Type: "form"},
+			err:   true,
+		},
+		{
+			name:  "twoSources",
+			login: &Login{Type: "bearer", URL: "/token", Token: &Extract{Regex: "a", JSONPath: "b"}},
+			err:   true,
+		},
+		{
+			name:  "invalidRegex",
+			login: &Login{Type: "bearer", URL: "/token", Token: &Extract{Regex: "("}},
+			err:   true,
+		},
+		{
+			name:  "emptyExpired",
+			login: &Login{Type: "form", URL: "/login", Expired: &Condition{}},
+			err:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLogin(tt.login)
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if tt.login.URL != tt.url || tt.login.TokenHeader != tt.header || tt.login.TokenPrefix != tt.prefix {
+				t.Errorf("want: %v %v %q\n got: %v %v %q", tt.url, tt.header, tt.prefix, tt.login.URL, tt.login.TokenHeader, tt.login.TokenPrefix)
+			}
+			if tt.login.Method != "POST" {
+				t.Errorf("want: POST\n got: %v", tt.login.Method)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+//openAPIMaxDepth limits how deep schemas are followed when building examples, which also
+//stops schemas referring to themselves
+const openAPIMaxDepth = 5
+
+//openAPIDocument holds the parts of an OpenAPI 3 document needed to build request templates
+type openAPIDocument struct {
+	OpenAPI    string                      `yaml:"openapi"`
+	Paths      map[string]*openAPIPathItem `yaml:"paths"`
+	Components openAPIComponents           `yaml:"components"`
+}
+
+type openAPIPathItem struct {
+	Parameters []*openAPIParameter `yaml:"parameters"`
+	Get        *openAPIOperation   `yaml:"get"`
+	Put        *openAPIOperation   `yaml:"put"`
+	Post       *openAPIOperation   `yaml:"post"`
+	Delete     *openAPIOperation   `yaml:"delete"`
+	Options    *openAPIOperation   `yaml:"options"`
+	Head       *openAPIOperation   `yaml:"head"`
+	Patch      *openAPIOperation   `yaml:"patch"`
+	Trace      *openAPIOperation   `yaml:"trace"`
+}
+
+type openAPIComponents struct {
+	Schemas       map[string]*openAPISchema      `yaml:"schemas"`
+	Parameters    map[string]*openAPIParameter   `yaml:"parameters"`
+	RequestBodies map[string]*openAPIRequestBody `yaml:"requestBodies"`
+}
+
+type openAPIOperation struct {
+	OperationID string              `yaml:"operationId"`
+	Parameters  []*openAPIParameter `yaml:"parameters"`
+	RequestBody *openAPIRequestBody `yaml:"requestBody"`
+}
+
+type openAPIParameter struct {
+	Ref     string         `yaml:"$ref"`
+	Name    string         `yaml:"name"`
+	In      string         `yaml:"in"`
+	Example interface{}    `yaml:"example"`
+	Schema  *openAPISchema `yaml:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Ref     string                       `yaml:"$ref"`
+	Content map[string]*openAPIMediaType `yaml:"content"`
+}
+
+type openAPIMediaType struct {
+	Schema  *openAPISchema `yaml:"schema"`
+	Example interface{}    `yaml:"example"`
+}
+
+type openAPISchema struct {
+	Ref        string                    `yaml:"$ref"`
+	Type       string                    `yaml:"type"`
+	Format     string                    `yaml:"format"`
+	Example    interface{}               `yaml:"example"`
+	Default    interface{}               `yaml:"default"`
+	Enum       []interface{}             `yaml:"enum"`
+	Properties map[string]*openAPISchema `yaml:"properties"`
+	Items      *openAPISchema            `yaml:"items"`
+}
+
+//LoadOpenAPI reads an OpenAPI 3 document in YAML or JSON and returns a request template for every
+//operation, with every declared parameter and top level body field filled with its example value
+func LoadOpenAPI(path string) ([]*RequestTemplate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc openAPIDocument
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI document %v: %v", path, err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("%v is not an OpenAPI 3 document", path)
+	}
+	var paths []string
+	for p := range doc.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	var templates []*RequestTemplate
+	for _, p := range paths {
+		item := doc.Paths[p]
+		if item == nil {
+			continue
+		}
+		operations := []*openAPIOperation{item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch, item.Trace}
+		for i, method := range []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"} {
+			if operations[i] == nil {
+				continue
+			}
+			//parameters declared on the path item apply to all of its operations
+			template, err := doc.template(p, method, operations[i], item.Parameters)
+			if err != nil {
+				return nil, err
+			}
+			templates = append(templates, template)
+		}
+	}
+	return templates, nil
+}
+
+//template builds the request template of an operation
+func (doc *openAPIDocument) template(path string, method string, op *openAPIOperation, common []*openAPIParameter) (*RequestTemplate, error) {
+	name := op.OperationID
+	if name == "" {
+		name = strings.ToUpper(method) + " " + path
+	}
+	t := &RequestTemplate{
+		Name:   name,
+		Method: strings.ToUpper(method),
+		Path:   path,
+	}
+	//operation parameters override path item parameters of the same name and location
+	params := make(map[string]*openAPIParameter)
+	var order []string
+	for _, p := range append(append([]*openAPIParameter{}, common...), op.Parameters...) {
+		param, err := doc.parameter(p)
+		if err != nil {
+			return nil, err
+		}
+		key := param.In + ":" + param.Name
+		if _, ok := params[key]; !ok {
+			order = append(order, key)
+		}
+		params[key] = param
+	}
+	for _, key := range order {
+		param := params[key]
+		value := &Parameter{Name: param.Name, Value: exampleString(param.Example, param.Schema, doc)}
+		switch param.In {
+		case "path":
+			t.PathParams = append(t.PathParams, value)
+		case "query":
+			t.Query = append(t.Query, value)
+		case "header":
+			t.Headers = append(t.Headers, value)
+		case "cookie":
+			t.Cookies = append(t.Cookies, value)
+		}
+	}
+	if op.RequestBody != nil {
+		if err := doc.body(t, op.RequestBody); err != nil {
+			return nil, fmt.Errorf("operation %v: %v", name, err)
+		}
+	}
+	return t, nil
+}
+
+//parameter resolves a parameter reference
+func (doc *openAPIDocument) parameter(p *openAPIParameter) (*openAPIParameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+	param, ok := doc.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+	if !ok {
+		return nil, fmt.Errorf("unresolved parameter reference %v", p.Ref)
+	}
+	return param, nil
+}
+
+//schema resolves a schema reference
+func (doc *openAPIDocument) schema(s *openAPISchema) *openAPISchema {
+	if s == nil || s.Ref == "" {
+		return s
+	}
+	return doc.Components.Schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]
+}
+
+//body fills the body of the template from a JSON or form request body. Only the top level fields of
+//an object schema are injected into, other bodies are sent with their example as is.
+func (doc *openAPIDocument) body(t *RequestTemplate, requestBody *openAPIRequestBody) error {
+	if requestBody.Ref != "" {
+		resolved, ok := doc.Components.RequestBodies[strings.TrimPrefix(requestBody.Ref, "#/components/requestBodies/")]
+		if !ok {
+			return fmt.Errorf("unresolved request body reference %v", requestBody.Ref)
+		}
+		requestBody = resolved
+	}
+	for _, contentType := range []string{"application/json", "application/x-www-form-urlencoded"} {
+		media, ok := requestBody.Content[contentType]
+		if !ok || media == nil {
+			continue
+		}
+		t.ContentType = contentType
+		schema := doc.schema(media.Schema)
+		example := exampleValue(media.Example, schema, doc, 0)
+		fields, ok := example.(map[string]interface{})
+		if !ok {
+			body, _ := json.Marshal(example)
+			t.Body = string(body)
+			return nil
+		}
+		for _, k := range sortedKeys(fields) {
+			value := &Parameter{Name: k}
+			if contentType == "application/json" {
+				raw, _ := json.Marshal(fields[k])
+				value.Value = string(raw)
+			} else {
+				value.Value = fmt.Sprint(fields[k])
+			}
+			t.BodyParams = append(t.BodyParams, value)
+		}
+		return nil
+	}
+	return nil
+}
+
+//exampleString returns the example of a parameter as it is written in a request
+func exampleString(example interface{}, schema *openAPISchema, doc *openAPIDocument) string {
+	v := exampleValue(example, doc.schema(schema), doc, 0)
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		raw, _ := json.Marshal(v)
+		return string(raw)
+	}
+	return fmt.Sprint(v)
+}
+
+//exampleValue returns the example of a value: the given example, the example, default or first enum
+//value of the schema, or a value of the schema type built from the properties and items of the schema
+func exampleValue(example interface{}, schema *openAPISchema, doc *openAPIDocument, depth int) interface{} {
+	if example != nil {
+		return jsonValue(example)
+	}
+	if schema == nil || depth > openAPIMaxDepth {
+		return "foo"
+	}
+	switch {
+	case schema.Example != nil:
+		return jsonValue(schema.Example)
+	case schema.Default != nil:
+		return jsonValue(schema.Default)
+	case len(schema.Enum) > 0:
+		return jsonValue(schema.Enum[0])
+	}
+	switch schema.Type {
+	case "integer":
+		return 1
+	case "number":
+		return 1.5
+	case "boolean":
+		return true
+	case "array":
+		return []interface{}{exampleValue(nil, doc.schema(schema.Items), doc, depth+1)}
+	case "object", "":
+		if len(schema.Properties) == 0 {
+			if schema.Type == "object" {
+				return map[string]interface{}{}
+			}
+			return "foo"
+		}
+		object := make(map[string]interface{})
+		for name, property := range schema.Properties {
+			object[name] = exampleValue(nil, doc.schema(property), doc, depth+1)
+		}
+		return object
+	}
+	switch schema.Format {
+	case "date":
+		return "2020-01-01"
+	case "date-time":
+		return "2020-01-01T00:00:00Z"
+	case "email":
+		return "foo@example.com"
+	case "uuid":
+		return "00000000-0000-0000-0000-000000000000"
+	}
+	return "foo"
+}
+
+//jsonValue converts the maps decoded from YAML so the value can be marshaled to JSON
+func jsonValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[interface{}]interface{}:
+		object := make(map[string]interface{})
+		for k, e := range value {
+			object[fmt.Sprint(k)] = jsonValue(e)
+		}
+		return object
+	case []interface{}:
+		array := make([]interface{}, len(value))
+		for i, e := range value {
+			array[i] = jsonValue(e)
+		}
+		return array
+	}
+	return v
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var openAPIDoc = `
+openapi: 3.0.1
+paths:
+  /users/{id}:
+    parameters:
+      - name: id
+        in: path
+        schema:
+          type: integer
+    get:
+      operationId: getUser
+      parameters:
+        - $ref: '#/components/parameters/Verbose'
+        - name: X-Tenant
+          in: header
+          example: acme
+        - name: session
+          in: cookie
+          schema:
+            type: string
+            format: uuid
+    put:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/User'
+components:
+  parameters:
+    Verbose:
+      name: verbose
+      in: query
+      schema:
+        type: boolean
+  schemas:
+    User:
+      type: object
+      properties:
+        name:
+          type: string
+          example: alice
+        tags:
+          type: array
+          items:
+            type: string
+            enum: [admin, user]
+`
+
+func TestLoadOpenAPI(t *testing.T) {
+	f, err := ioutil.TempFile("", "openapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(openAPIDoc)
+	f.Close()
+	want := []*RequestTemplate{
+		{
+			Name:       "getUser",
+			Method:     "GET",
+			Path:       "/users/{id}",
+			PathParams: []*Parameter{{Name: "id", Value: "1"}},
+			Query:      []*Parameter{{Name: "verbose", Value: "true"}},
+			Headers:    []*Parameter{{Name: "X-Tenant", Value: "acme"}},
+			Cookies:    []*Parameter{{Name: "session", Value: "00000000-0000-0000-0000-000000000000"}},
+		},
+		{
+			Name:        "PUT /users/{id}",
+			Method:      "PUT",
+			Path:        "/users/{id}",
+			PathParams:  []*Parameter{{Name: "id", Value: "1"}},
+			ContentType: "application/json",
+			BodyParams:  []*Parameter{{Name: "name", Value: `"alice"`}, {Name: "tags", Value: `["admin"]`}},
+		},
+	}
+	got, err := LoadOpenAPI(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok := cmp.Equal(want, got); !ok {
+		diff := cmp.Diff(want, got)
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	var labels []string
+	for _, template := range got {
+		for _, l := range template.Locations("openapi", func(string, string) bool { return true }) {
+			labels = append(labels, l.Label())
+		}
+	}
+	wantLabels := []string{
+		"openapi getUser path:id",
+		"openapi getUser queryarg:verbose",
+		"openapi getUser header:X-Tenant",
+		"openapi getUser cookie:session",
+		"openapi PUT /users/{id} path:id",
+		"openapi PUT /users/{id} body:name",
+		"openapi PUT /users/{id} body:tags",
+	}
+	if ok := cmp.Equal(wantLabels, labels); !ok {
+		diff := cmp.Diff(wantLabels, labels)
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,363 @@
+package config
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+//postmanVariable matches the {{name}} references to collection and environment variables
+var postmanVariable = regexp.MustCompile(`\{\{([^{}]+)\}\}`)
+
+//postmanCollection holds the parts of a Postman v2.1 collection needed to build request templates
+type postmanCollection struct {
+	Info struct {
+		Schema string `json:"schema"`
+	} `json:"info"`
+	Item     []*postmanItem     `json:"item"`
+	Auth     *postmanAuth       `json:"auth"`
+	Variable []*postmanKeyValue `json:"variable"`
+}
+
+//postmanItem is a request or a folder of items
+type postmanItem struct {
+	Name    string          `json:"name"`
+	Item    []*postmanItem  `json:"item"`
+	Auth    *postmanAuth    `json:"auth"`
+	Request json.RawMessage `json:"request"`
+}
+
+type postmanRequest struct {
+	Method string             `json:"method"`
+	Header []*postmanKeyValue `json:"header"`
+	URL    json.RawMessage    `json:"url"`
+	Body   *postmanBody       `json:"body"`
+	Auth   *postmanAuth       `json:"auth"`
+}
+
+type postmanURL struct {
+	Raw      string             `json:"raw"`
+	Path     json.RawMessage    `json:"path"`
+	Query    []*postmanKeyValue `json:"query"`
+	Variable []*postmanKeyValue `json:"variable"`
+}
+
+type postmanBody struct {
+	Mode       string             `json:"mode"`
+	Raw        string             `json:"raw"`
+	URLEncoded []*postmanKeyValue `json:"urlencoded"`
+	FormData   []*postmanKeyValue `json:"formdata"`
+	GraphQL    *struct {
+		Query     string `json:"query"`
+		Variables string `json:"variables"`
+	} `json:"graphql"`
+	Options struct {
+		Raw struct {
+			Language string `json:"language"`
+		} `json:"raw"`
+	} `json:"options"`
+}
+
+type postmanAuth struct {
+	Type   string             `json:"type"`
+	Bearer []*postmanKeyValue `json:"bearer"`
+	Basic  []*postmanKeyValue `json:"basic"`
+	APIKey []*postmanKeyValue `json:"apikey"`
+}
+
+//postmanKeyValue is a header, query parameter, body field or variable. Collection entries are
+//turned off with disabled and environment values with enabled.
+type postmanKeyValue struct {
+	Key      string      `json:"key"`
+	Value    interface{} `json:"value"`
+	Type     string      `json:"type"`
+	Disabled bool        `json:"disabled"`
+	Enabled  *bool       `json:"enabled"`
+}
+
+//postmanEnvironment is an environment exported from Postman
+type postmanEnvironment struct {
+	Values []*postmanKeyValue `json:"values"`
+}
+
+//postmanLoader resolves the variables of a collection while its items are walked
+type postmanLoader struct {
+	variables map[string]string
+}
+
+//LoadPostman reads a Postman v2.1 collection and returns a request template for every request of the
+//collection and its folders. Variables are substituted with the values of the environment, when given,
+//and then of the collection.
+func LoadPostman(path string, environment string) ([]*RequestTemplate, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var collection postmanCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("invalid Postman collection %v: %v", path, err)
+	}
+	if collection.Info.Schema != "" && !strings.Contains(collection.Info.Schema, "v2.1") {
+		return nil, fmt.Errorf("%v is not a Postman v2.1 collection", path)
+	}
+	loader := &postmanLoader{variables: make(map[string]string)}
+	for _, v := range collection.Variable {
+		loader.variables[v.Key] = v.String()
+	}
+	if environment != "" {
+		data, err := ioutil.ReadFile(environment)
+		if err != nil {
+			return nil, err
+		}
+		var env postmanEnvironment
+		if err := json.Unmarshal(data, &env); err != nil {
+			return nil, fmt.Errorf("invalid Postman environment %v: %v", environment, err)
+		}
+		for _, v := range env.Values {
+			if v.Enabled == nil || *v.Enabled {
+				loader.variables[v.Key] = v.String()
+			}
+		}
+	}
+	templates, err := loader.items(collection.Item, "", collection.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request in Postman collection %v: %v", path, err)
+	}
+	return templates, nil
+}
+
+//items returns the templates of the requests of a folder. Requests without auth inherit the auth
+//of their folder.
+func (l *postmanLoader) items(items []*postmanItem, folder string, auth *postmanAuth) ([]*RequestTemplate, error) {
+	var templates []*RequestTemplate
+	for _, item := range items {
+		name := folder + item.Name
+		itemAuth := auth
+		if item.Auth != nil {
+			itemAuth = item.Auth
+		}
+		if item.Request == nil {
+			folderTemplates, err := l.items(item.Item, name+"/", itemAuth)
+			if err != nil {
+				return nil, err
+			}
+			templates = append(templates, folderTemplates...)
+			continue
+		}
+		template, err := l.template(item.Request, itemAuth)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", name, err)
+		}
+		if item.Name != "" {
+			template.Name = name
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+//template builds the template of a request, which is either an object or the URL of a GET request
+func (l *postmanLoader) template(raw json.RawMessage, auth *postmanAuth) (*RequestTemplate, error) {
+	var req postmanRequest
+	var rawURL string
+	if err := json.Unmarshal(raw, &rawURL); err == nil {
+		req.URL = raw
+	} else if err := json.Unmarshal(raw, &req); err != nil {
+		return nil, err
+	}
+	if req.Method == "" {
+		req.Method = "GET"
+	}
+	if req.Auth != nil {
+		auth = req.Auth
+	}
+	target, pathVariables, err := l.url(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	var headers []*Parameter
+	for _, h := range req.Header {
+		if !h.Disabled {
+			headers = append(headers, &Parameter{Name: l.substitute(h.Key), Value: l.substitute(h.String())})
+		}
+	}
+	headers, target = l.auth(auth, headers, target)
+	contentType, body := l.body(req.Body)
+	t, err := capturedTemplate(req.Method, target, headers, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	//path variables such as :id become parameters of the template path
+	segments := strings.Split(t.Path, "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, ":") || len(segment) == 1 {
+			continue
+		}
+		name := segment[1:]
+		segments[i] = "{" + name + "}"
+		t.PathParams = append(t.PathParams, &Parameter{Name: name, Value: pathVariables[name]})
+	}
+	t.Path = strings.Join(segments, "/")
+	t.Name = t.Method + " " + t.Path
+	return t, nil
+}
+
+//url returns the path and query of the request URL with the variables substituted, and the values
+//of its path variables. The host is dropped since requests are sent to the WAF.
+func (l *postmanLoader) url(raw json.RawMessage) (string, map[string]string, error) {
+	pathVariables := make(map[string]string)
+	var u postmanURL
+	if err := json.Unmarshal(raw, &u.Raw); err != nil {
+		if err := json.Unmarshal(raw, &u); err != nil {
+			return "", nil, err
+		}
+	}
+	for _, v := range u.Variable {
+		pathVariables[v.Key] = l.substitute(v.String())
+	}
+	var segments []string
+	if err := json.Unmarshal(u.Path, &segments); err != nil {
+		//the raw URL is used when the path is not given as segments
+		var path string
+		if err := json.Unmarshal(u.Path, &path); err == nil && path != "" {
+			segments = strings.Split(strings.TrimPrefix(path, "/"), "/")
+		} else {
+			return l.rawURL(u.Raw), pathVariables, nil
+		}
+	}
+	for i, s := range segments {
+		segments[i] = l.substitute(s)
+	}
+	target := "/" + strings.Join(segments, "/")
+	var query []string
+	for _, q := range u.Query {
+		if q.Disabled {
+			continue
+		}
+		field := url.QueryEscape(l.substitute(q.Key))
+		if q.Value != nil {
+			field += "=" + url.QueryEscape(l.substitute(q.String()))
+		}
+		query = append(query, field)
+	}
+	if len(query) > 0 {
+		target += "?" + strings.Join(query, "&")
+	}
+	return target, pathVariables, nil
+}
+
+//rawURL returns the path and query of a raw URL
+func (l *postmanLoader) rawURL(raw string) string {
+	raw = l.substitute(raw)
+	if i := strings.Index(raw, "://"); i >= 0 {
+		raw = raw[i+3:]
+	}
+	//drop the host, which may be an unresolved variable
+	if i := strings.IndexAny(raw, "/?"); i >= 0 {
+		if raw[i] == '?' {
+			return "/" + raw[i:]
+		}
+		return raw[i:]
+	}
+	return "/"
+}
+
+//auth adds the credentials of bearer, basic and API key auth to the request
+func (l *postmanLoader) auth(auth *postmanAuth, headers []*Parameter, target string) ([]*Parameter, string) {
+	if auth == nil {
+		return headers, target
+	}
+	values := func(kv []*postmanKeyValue) map[string]string {
+		m := make(map[string]string)
+		for _, v := range kv {
+			m[v.Key] = l.substitute(v.String())
+		}
+		return m
+	}
+	switch auth.Type {
+	case "bearer":
+		headers = append(headers, &Parameter{Name: "Authorization", Value: "Bearer " + values(auth.Bearer)["token"]})
+	case "basic":
+		v := values(auth.Basic)
+		credentials := base64.StdEncoding.EncodeToString([]byte(v["username"] + ":" + v["password"]))
+		headers = append(headers, &Parameter{Name: "Authorization", Value: "Basic " + credentials})
+	case "apikey":
+		v := values(auth.APIKey)
+		if v["in"] == "query" {
+			separator := "?"
+			if strings.Contains(target, "?") {
+				separator = "&"
+			}
+			target += separator + url.QueryEscape(v["key"]) + "=" + url.QueryEscape(v["value"])
+		} else {
+			headers = append(headers, &Parameter{Name: v["key"], Value: v["value"]})
+		}
+	}
+	return headers, target
+}
+
+//body returns the content type and body of a request. Text fields of multipart bodies are sent
+//as a form since request templates do not build multipart bodies.
+func (l *postmanLoader) body(body *postmanBody) (string, string) {
+	if body == nil {
+		return "", ""
+	}
+	form := func(fields []*postmanKeyValue) string {
+		var encoded []string
+		for _, f := range fields {
+			if f.Disabled || (f.Type != "" && f.Type != "text") {
+				continue
+			}
+			encoded = append(encoded, url.QueryEscape(l.substitute(f.Key))+"="+url.QueryEscape(l.substitute(f.String())))
+		}
+		return strings.Join(encoded, "&")
+	}
+	switch body.Mode {
+	case "raw":
+		contentType := ""
+		if body.Options.Raw.Language == "json" {
+			contentType = "application/json"
+		}
+		return contentType, l.substitute(body.Raw)
+	case "urlencoded":
+		return "application/x-www-form-urlencoded", form(body.URLEncoded)
+	case "formdata":
+		return "application/x-www-form-urlencoded", form(body.FormData)
+	case "graphql":
+		if body.GraphQL == nil {
+			return "", ""
+		}
+		query, _ := json.Marshal(l.substitute(body.GraphQL.Query))
+		graphql := `{"query":` + string(query)
+		if variables := strings.TrimSpace(l.substitute(body.GraphQL.Variables)); json.Valid([]byte(variables)) {
+			graphql += `,"variables":` + variables
+		}
+		return "application/json", graphql + "}"
+	}
+	return "", ""
+}
+
+//substitute replaces the variables known to the loader, leaving unknown variables as they are
+func (l *postmanLoader) substitute(s string) string {
+	return postmanVariable.ReplaceAllStringFunc(s, func(ref string) string {
+		if value, ok := l.variables[strings.TrimSpace(ref[2:len(ref)-2])]; ok {
+			return value
+		}
+		return ref
+	})
+}
+
+//String returns the value as text, since values may be given as numbers or booleans
+func (kv *postmanKeyValue) String() string {
+	switch value := kv.Value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	}
+	return fmt.Sprint(kv.Value)
+}
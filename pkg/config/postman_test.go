@@ -0,0 +1,173 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var postmanCollectionJSON = `{
+  "info": {"name": "shop", "schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},
+  "auth": {"type": "bearer", "bearer": [{"key": "token", "value": "{{token}}", "type": "string"}]},
+  "variable": [{"key": "baseUrl", "value": "https://shop.example.com"}, {"key": "token", "value": "collection"}],
+  "item": [
+    {
+      "name": "users",
+      "item": [
+        {
+          "name": "get user",
+          "request": {
+            "method": "GET",
+            "header": [{"key": "X-Tenant", "value": "{{tenant}}"}, {"key": "X-Debug", "value": "1", "disabled": true}],
+            "url": {
+              "raw": "{{baseUrl}}/users/:id?verbose=true",
+              "host": ["{{baseUrl}}"],
+              "path": ["users", ":id"],
+              "query": [{"key": "verbose", "value": "true"}, {"key": "trace", "value": "1", "disabled": true}],
+              "variable": [{"key": "id", "value": "{{userId}}"}]
+            }
+          }
+        },
+        {
+          "name": "update user",
+          "request": {
+            "auth": {"type": "noauth"},
+            "method": "PUT",
+            "url": "{{baseUrl}}/users/42",
+            "body": {"mode": "raw", "raw": "{\"name\": \"{{name}}\"}", "options": {"raw": {"language": "json"}}}
+          }
+        }
+      ]
+    },
+    {
+      "name": "login",
+      "request": {
+        "method": "POST",
+        "url": "{{unknown}}/login",
+        "body": {"mode": "urlencoded", "urlencoded": [{"key": "user", "value": "alice"}, {"key": "remember", "value": true}]}
+      }
+    },
+    {
+      "name": "search",
+      "request": "https://shop.example.com/search?q=shoes"
+    }
+  ]
+}`
+
+var postmanEnvironmentJSON = `{
+  "name": "staging",
+  "values": [
+    {"key": "token", "value": "staging", "enabled": true},
+    {"key": "tenant", "value": "acme", "enabled": true},
+    {"key": "userId", "value": 7, "enabled": true},
+    {"key": "name", "value": "bob", "enabled": false}
+  ]
+}`
+
+func TestLoadPostman(t *testing.T) {
+	collection, err := ioutil.TempFile("", "postman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(collection.Name())
+	collection.WriteString(postmanCollectionJSON)
+	collection.Close()
+	environment, err := ioutil.TempFile("", "postman")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(environment.Name())
+	environment.WriteString(postmanEnvironmentJSON)
+	environment.Close()
+	var tests = []struct {
+		name        string
+		environment string
+		want        []*RequestTemplate
+	}{
+		{
+			name:        "environment",
+			environment: environment.Name(),
+			want: []*RequestTemplate{
+				{
+					Name:       "users/get user",
+					Method:     "GET",
+					Path:       "/users/{id}",
+					PathParams: []*Parameter{{Name: "id", Value: "7"}},
+					Query:      []*Parameter{{Name: "verbose", Value: "true"}},
+					Headers:    []*Parameter{{Name: "X-Tenant", Value: "acme"}, {Name: "Authorization", Value: "Bearer staging"}},
+				},
+				{
+					Name:        "users/update user",
+					Method:      "PUT",
+					Path:        "/users/42",
+					ContentType: "application/json",
+					BodyParams:  []*Parameter{{Name: "name", Value: `"{{name}}"`}},
+				},
+				{
+					Name:        "login",
+					Method:      "POST",
+					Path:        "/login",
+					Headers:     []*Parameter{{Name: "Authorization", Value: "Bearer staging"}},
+					ContentType: "application/x-www-form-urlencoded",
+					BodyParams:  []*Parameter{{Name: "user", Value: "alice"}, {Name: "remember", Value: "true"}},
+				},
+				{
+					Name:    "search",
+					Method:  "GET",
+					Path:    "/search",
+					Query:   []*Parameter{{Name: "q", Value: "shoes"}},
+					Headers: []*Parameter{{Name: "Authorization", Value: "Bearer staging"}},
+				},
+			},
+		},
+		{
+			name: "collection variables",
+			want: []*RequestTemplate{
+				{
+					Name:       "users/get user",
+					Method:     "GET",
+					Path:       "/users/{id}",
+					PathParams: []*Parameter{{Name: "id", Value: "{{userId}}"}},
+					Query:      []*Parameter{{Name: "verbose", Value: "true"}},
+					Headers:    []*Parameter{{Name: "X-Tenant", Value: "{{tenant}}"}, {Name: "Authorization", Value: "Bearer collection"}},
+				},
+				{
+					Name:        "users/update user",
+					Method:      "PUT",
+					Path:        "/users/42",
+					ContentType: "application/json",
+					BodyParams:  []*Parameter{{Name: "name", Value: `"{{name}}"`}},
+				},
+				{
+					Name:        "login",
+					Method:      "POST",
+					Path:        "/login",
+					Headers:     []*Parameter{{Name: "Authorization", Value: "Bearer collection"}},
+					ContentType: "application/x-www-form-urlencoded",
+					BodyParams:  []*Parameter{{Name: "user", Value: "alice"}, {Name: "remember", Value: "true"}},
+				},
+				{
+					Name:    "search",
+					Method:  "GET",
+					Path:    "/search",
+					Query:   []*Parameter{{Name: "q", Value: "shoes"}},
+					Headers: []*Parameter{{Name: "Authorization", Value: "Bearer collection"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadPostman(collection.Name(), tt.environment)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok := cmp.Equal(tt.want, got); !ok {
+				diff := cmp.Diff(tt.want, got)
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+//Prefetch is a request sent before every test request of a WAF to fetch fresh values, such as CSRF
+//tokens or nonces, that are placed in the test request before it is sent
+type Prefetch struct {
+	URL     string           `yaml:"url"`
+	Method  string           `yaml:"method"`
+	Headers []*Header        `yaml:"headers"`
+	Values  []*PrefetchValue `yaml:"values"`
+}
+
+//PrefetchValue is a value extracted from the prefetch response and set in the header SetHeader or
+//the form or JSON body field SetField of the test request
+type PrefetchValue struct {
+	Extract   `yaml:",inline"`
+	SetHeader string `yaml:"set_header"`
+	SetField  string `yaml:"set_field"`
+}
+
+//validatePrefetch checks the prefetch step of a WAF and fills in its defaults. Prefetch URLs starting
+//with a slash are paths on the WAF, sent to the host of each test set of the WAF.
+func validatePrefetch(prefetch *Prefetch) error {
+	if prefetch.URL == "" {
+		return fmt.Errorf("prefetch requires a url")
+	}
+	if u, err := url.Parse(prefetch.URL); err != nil || (u.Host == "" && !strings.HasPrefix(prefetch.URL, "/")) {
+		return fmt.Errorf("invalid prefetch url %q", prefetch.URL)
+	}
+	prefetch.Method = strings.ToUpper(prefetch.Method)
+	if prefetch.Method == "" {
+		prefetch.Method = http.MethodGet
+	}
+	for _, h := range prefetch.Headers {
+		h.Header = http.CanonicalHeaderKey(h.Header)
+	}
+	if len(prefetch.Values) == 0 {
+		return fmt.Errorf("prefetch requires values")
+	}
+	for _, v := range prefetch.Values {
+		if err := validateExtract(&v.Extract); err != nil {
+			return fmt.Errorf("prefetch value: %v", err)
+		}
+		if (v.SetHeader == "") == (v.SetField == "") {
+			return fmt.Errorf("prefetch value requires one of set_header and set_field")
+		}
+		v.SetHeader = http.CanonicalHeaderKey(v.SetHeader)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"testing"
+)
+
+func TestValidatePrefetch(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefetch *Prefetch
+		url      string
+		err      bool
+	}{
+		{
+			name: "relative",
+			prefetch: &Prefetch{URL: "/form", Values: []*PrefetchValue{
+				{Extract: Extract{Selector: "input[name=csrf]"}, SetField: "csrf"},
+				{Extract: Extract{Regex: `nonce="(\w+)"`}, SetHeader: "x-nonce"},
+			}},
+			url: "/form",
+		},
+		{
+			name:     "noValues",
+			prefetch: &Prefetch{URL: "/form"},
+			err:      true,
+		},
+		{
+			name:     "noTarget",
+			prefetch: &Prefetch{URL: "/form", Values: []*PrefetchValue{{Extract: Extract{JSONPath: "csrf"}}}},
+			err:      true,
+		},
+		{
+			name: "twoTargets",
+			prefetch: &Prefetch{URL: "/form", Values: []*PrefetchValue{
+				{Extract: Extract{JSONPath: "csrf"}, SetHeader: "X-Csrf", SetField: "csrf"},
+			}},
+			err: true,
+		},
+		{
+			name:     "attributeWithoutSelector",
+			prefetch: &Prefetch{URL: "/form", Values: []*PrefetchValue{{Extract: Extract{JSONPath: "csrf", Attribute: "value"}, SetField: "csrf"}}},
+			err:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePrefetch(tt.prefetch)
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if tt.prefetch.URL != tt.url || tt.prefetch.Method != "GET" {
+				t.Errorf("want: GET %v\n got: %v %v", tt.url, tt.prefetch.Method, tt.prefetch.URL)
+			}
+			if tt.prefetch.Values[1].SetHeader != "X-Nonce" {
+				t.Errorf("want: X-Nonce\n got: %v", tt.prefetch.Values[1].SetHeader)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package config
+
+import "fmt"
+
+//RateLimit paces the test requests sent to a WAF. Rates are requests per second.
+type RateLimit struct {
+	Rate        int  `yaml:"rate" json:",omitempty"`
+	Concurrency int  `yaml:"concurrency" json:",omitempty"`
+	Adaptive    bool `yaml:"adaptive" json:",omitempty"`
+	MinRate     int  `yaml:"min_rate" json:",omitempty"`
+}
+
+//validateRateLimit checks the rates and concurrency of a rate limit are not negative and that the
+//minimum rate of adaptive throttling is below the rate, defaulting it to 1 request per second
+func validateRateLimit(rateLimit *RateLimit) error {
+	if rateLimit == nil {
+		return nil
+	}
+	if rateLimit.Rate < 0 || rateLimit.Concurrency < 0 || rateLimit.MinRate < 0 {
+		return fmt.Errorf("rate_limit values can not be negative")
+	}
+	if !rateLimit.Adaptive {
+		if rateLimit.MinRate != 0 {
+			return fmt.Errorf("min_rate requires adaptive rate limiting")
+		}
+		return nil
+	}
+	if rateLimit.MinRate == 0 {
+		rateLimit.MinRate = 1
+	}
+	if rateLimit.Rate != 0 && rateLimit.MinRate > rateLimit.Rate {
+		return fmt.Errorf("min_rate %v is above rate %v", rateLimit.MinRate, rateLimit.Rate)
+	}
+	return nil
+}
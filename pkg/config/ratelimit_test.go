@@ -0,0 +1,41 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateRateLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		rateLimit *RateLimit
+		want      *RateLimit
+		wantErr   bool
+	}{
+		{name: "none"},
+		{name: "rate", rateLimit: &RateLimit{Rate: 10, Concurrency: 2}, want: &RateLimit{Rate: 10, Concurrency: 2}},
+		{name: "adaptive", rateLimit: &RateLimit{Rate: 10, Adaptive: true}, want: &RateLimit{Rate: 10, Adaptive: true, MinRate: 1}},
+		{name: "adaptiveMinRate", rateLimit: &RateLimit{Adaptive: true, MinRate: 5}, want: &RateLimit{Adaptive: true, MinRate: 5}},
+		{name: "negative", rateLimit: &RateLimit{Rate: -1}, wantErr: true},
+		{name: "minRateNotAdaptive", rateLimit: &RateLimit{Rate: 10, MinRate: 2}, wantErr: true},
+		{name: "minRateAboveRate", rateLimit: &RateLimit{Rate: 10, Adaptive: true, MinRate: 20}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRateLimit(tt.rateLimit)
+			if err != nil && !tt.wantErr {
+				t.Errorf("%v", err)
+			}
+			if err == nil && tt.wantErr {
+				t.Errorf("no expected error")
+			}
+			if err == nil {
+				if diff := cmp.Diff(tt.want, tt.rateLimit); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
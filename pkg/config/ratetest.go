@@ -0,0 +1,70 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+//defaultRateTestDuration and defaultRateTestRecovery are how long each rate of a rate test is sent
+//for, and how long a rate test waits for blocking to end, unless set
+const (
+	defaultRateTestDuration = 10 * time.Second
+	defaultRateTestRecovery = time.Minute
+)
+
+//RateTest is the rate limiting test of a WAF, sending bursts of benign requests at increasing rates
+//until the WAF starts blocking them
+type RateTest struct {
+	Method string `yaml:"method" json:",omitempty"`
+	//Paths are appended to the path of the test set URI in turn, the set URI alone when empty
+	Paths []string `yaml:"paths" json:",omitempty"`
+	//Rates are the requests per second of each burst, sent in order
+	Rates     []int  `yaml:"rates"`
+	Duration  string `yaml:"duration" json:",omitempty"`
+	Recovery  string `yaml:"recovery" json:",omitempty"`
+	RotateXFF bool   `yaml:"rotate_xff" json:",omitempty"`
+
+	StepDuration     time.Duration `yaml:"-" json:"-"`
+	RecoveryDuration time.Duration `yaml:"-" json:"-"`
+}
+
+//validateRateTest checks the rates of a rate test increase and fills in the defaults
+func validateRateTest(rateTest *RateTest) error {
+	if rateTest == nil {
+		return nil
+	}
+	if len(rateTest.Rates) == 0 {
+		return fmt.Errorf("rate_test requires rates")
+	}
+	for i, rate := range rateTest.Rates {
+		if rate <= 0 {
+			return fmt.Errorf("invalid rate_test rate %v", rate)
+		}
+		if i > 0 && rate <= rateTest.Rates[i-1] {
+			return fmt.Errorf("rate_test rates must increase")
+		}
+	}
+	if rateTest.Method == "" {
+		rateTest.Method = "GET"
+	}
+	for i, path := range rateTest.Paths {
+		if !strings.HasPrefix(path, "/") {
+			rateTest.Paths[i] = "/" + path
+		}
+	}
+	var err error
+	if rateTest.StepDuration, err = parseDuration("rate_test duration", rateTest.Duration); err != nil {
+		return err
+	}
+	if rateTest.StepDuration == 0 {
+		rateTest.StepDuration = defaultRateTestDuration
+	}
+	if rateTest.RecoveryDuration, err = parseDuration("rate_test recovery", rateTest.Recovery); err != nil {
+		return err
+	}
+	if rateTest.RecoveryDuration == 0 {
+		rateTest.RecoveryDuration = defaultRateTestRecovery
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateRateTest(t *testing.T) {
+	tests := []struct {
+		name     string
+		rateTest *RateTest
+		want     *RateTest
+		wantErr  bool
+	}{
+		{name: "none"},
+		{
+			name:     "defaults",
+			rateTest: &RateTest{Rates: []int{10, 50}, Paths: []string{"login", "/search"}},
+			want: &RateTest{
+				Method:           "GET",
+				Rates:            []int{10, 50},
+				Paths:            []string{"/login", "/search"},
+				StepDuration:     defaultRateTestDuration,
+				RecoveryDuration: defaultRateTestRecovery,
+			},
+		},
+		{
+			name:     "custom",
+			rateTest: &RateTest{Method: "POST", Rates: []int{5}, Duration: "2s", Recovery: "30s", RotateXFF: true},
+			want: &RateTest{
+				Method:           "POST",
+				Rates:            []int{5},
+				Duration:         "2s",
+				Recovery:         "30s",
+				RotateXFF:        true,
+				StepDuration:     2 * time.Second,
+				RecoveryDuration: 30 * time.Second,
+			},
+		},
+		{name: "noRates", rateTest: &RateTest{}, wantErr: true},
+		{name: "decreasingRates", rateTest: &RateTest{Rates: []int{50, 10}}, wantErr: true},
+		{name: "zeroRate", rateTest: &RateTest{Rates: []int{0, 10}}, wantErr: true},
+		{name: "invalidDuration", rateTest: &RateTest{Rates: []int{10}, Duration: "10"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRateTest(tt.rateTest)
+			if err != nil && !tt.wantErr {
+				t.Errorf("%v", err)
+			}
+			if err == nil && tt.wantErr {
+				t.Errorf("no expected error")
+			}
+			if err == nil {
+				if diff := cmp.Diff(tt.want, tt.rateTest); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
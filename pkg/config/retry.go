@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+//defaultBackoff and defaultMaxBackoff are the delays before the first retry and the longest delay
+//between retries of a retry policy without its own
+const (
+	defaultBackoff    = 500 * time.Millisecond
+	defaultMaxBackoff = 10 * time.Second
+)
+
+//Timeouts are the time allowed to connect to a WAF and to receive the response of a test request
+//once connected, as durations such as 500ms or 5s
+type Timeouts struct {
+	Connect string `yaml:"connect"`
+	Read    string `yaml:"read"`
+}
+
+//Retry is the policy of resending test requests that fail with a transient error such as a
+//connection reset or a timeout
+type Retry struct {
+	Attempts   int    `yaml:"attempts"`
+	Backoff    string `yaml:"backoff"`
+	MaxBackoff string `yaml:"max_backoff"`
+}
+
+//RetryPolicy is a parsed retry policy. Attempts counts the first request, and the delay before
+//each retry doubles from Backoff up to MaxBackoff.
+type RetryPolicy struct {
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+//parseTimeouts returns the connect and read timeouts, which are zero when not set
+func parseTimeouts(timeouts *Timeouts) (time.Duration, time.Duration, error) {
+	if timeouts == nil {
+		return 0, 0, nil
+	}
+	connect, err := parseDuration("connect timeout", timeouts.Connect)
+	if err != nil {
+		return 0, 0, err
+	}
+	read, err := parseDuration("read timeout", timeouts.Read)
+	if err != nil {
+		return 0, 0, err
+	}
+	return connect, read, nil
+}
+
+//parseRetry checks a retry policy and fills in the default delays
+func parseRetry(retry *Retry) (*RetryPolicy, error) {
+	if retry == nil {
+		return nil, nil
+	}
+	if retry.Attempts < 1 {
+		return nil, fmt.Errorf("retry requires at least 1 attempt")
+	}
+	backoff, err := parseDuration("backoff", retry.Backoff)
+	if err != nil {
+		return nil, err
+	}
+	if backoff == 0 {
+		backoff = defaultBackoff
+	}
+	maxBackoff, err := parseDuration("max_backoff", retry.MaxBackoff)
+	if err != nil {
+		return nil, err
+	}
+	if maxBackoff == 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	if maxBackoff < backoff {
+		return nil, fmt.Errorf("max_backoff %v is shorter than backoff %v", maxBackoff, backoff)
+	}
+	return &RetryPolicy{
+		Attempts:   retry.Attempts,
+		Backoff:    backoff,
+		MaxBackoff: maxBackoff,
+	}, nil
+}
+
+//parseDuration parses an optional positive duration
+func parseDuration(name string, value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid %v %q", name, value)
+	}
+	return d, nil
+}
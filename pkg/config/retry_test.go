@@ -0,0 +1,78 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseTimeouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeouts    *Timeouts
+		wantConnect time.Duration
+		wantRead    time.Duration
+		wantErr     bool
+	}{
+		{name: "none"},
+		{name: "both", timeouts: &Timeouts{Connect: "2s", Read: "500ms"}, wantConnect: 2 * time.Second, wantRead: 500 * time.Millisecond},
+		{name: "read", timeouts: &Timeouts{Read: "1m"}, wantRead: time.Minute},
+		{name: "invalid", timeouts: &Timeouts{Connect: "2"}, wantErr: true},
+		{name: "negative", timeouts: &Timeouts{Read: "-1s"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connect, read, err := parseTimeouts(tt.timeouts)
+			if err != nil && !tt.wantErr {
+				t.Errorf("%v", err)
+			}
+			if err == nil && tt.wantErr {
+				t.Errorf("no expected error")
+			}
+			if connect != tt.wantConnect || read != tt.wantRead {
+				t.Errorf("want: %v %v\n got: %v %v", tt.wantConnect, tt.wantRead, connect, read)
+			}
+		})
+	}
+}
+
+func TestParseRetry(t *testing.T) {
+	tests := []struct {
+		name    string
+		retry   *Retry
+		want    *RetryPolicy
+		wantErr bool
+	}{
+		{name: "none"},
+		{
+			name:  "defaults",
+			retry: &Retry{Attempts: 3},
+			want:  &RetryPolicy{Attempts: 3, Backoff: defaultBackoff, MaxBackoff: defaultMaxBackoff},
+		},
+		{
+			name:  "custom",
+			retry: &Retry{Attempts: 5, Backoff: "100ms", MaxBackoff: "1s"},
+			want:  &RetryPolicy{Attempts: 5, Backoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+		},
+		{name: "noAttempts", retry: &Retry{Backoff: "1s"}, wantErr: true},
+		{name: "invalidBackoff", retry: &Retry{Attempts: 2, Backoff: "soon"}, wantErr: true},
+		{name: "maxBelowBackoff", retry: &Retry{Attempts: 2, Backoff: "2s", MaxBackoff: "1s"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRetry(tt.retry)
+			if err != nil && !tt.wantErr {
+				t.Errorf("%v", err)
+			}
+			if err == nil && tt.wantErr {
+				t.Errorf("no expected error")
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+//ScenarioVariable matches the {{name}} references to the payload and to the variables extracted by
+//earlier steps of a scenario
+var ScenarioVariable = regexp.MustCompile(`\{\{\s*([\w.-]+)\s*\}\}`)
+
+//PayloadVariables are the forms of the payload a scenario step can reference: as it is, URL encoded,
+//or escaped for a JSON string
+var PayloadVariables = []string{"payload", "payload_urlencoded", "payload_json"}
+
+//Scenario is an ordered list of requests sharing cookies and variables, such as creating a cart,
+//adding an item holding the payload and checking out, tested as a single location
+type Scenario struct {
+	Name  string  `yaml:"name"`
+	Steps []*Step `yaml:"steps"`
+}
+
+//Step is a request of a scenario. Check marks the steps whose responses are matched against the
+//block condition, which default to the steps holding the payload.
+type Step struct {
+	Name    string      `yaml:"name"`
+	Method  string      `yaml:"method"`
+	Path    string      `yaml:"path"`
+	Headers []*Header   `yaml:"headers"`
+	Body    string      `yaml:"body"`
+	Extract []*Variable `yaml:"extract"`
+	Check   bool        `yaml:"check"`
+}
+
+//Variable is a value extracted from the response of a step for the steps after it
+type Variable struct {
+	Extract  `yaml:",inline"`
+	Variable string `yaml:"variable"`
+}
+
+//LoadScenario reads a scenario file and checks its steps only reference the payload and the
+//variables of earlier steps
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var scenario Scenario
+	if err := yaml.UnmarshalStrict(data, &scenario); err != nil {
+		return nil, fmt.Errorf("invalid scenario %v: %v", path, err)
+	}
+	if scenario.Name == "" {
+		scenario.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if len(scenario.Steps) == 0 {
+		return nil, fmt.Errorf("scenario %v has no steps", scenario.Name)
+	}
+	known := make(map[string]bool)
+	payloadVariable := make(map[string]bool)
+	for _, v := range PayloadVariables {
+		known[v] = true
+		payloadVariable[v] = true
+	}
+	var payloadSteps []*Step
+	checked := false
+	for i, step := range scenario.Steps {
+		if step.Name == "" {
+			step.Name = fmt.Sprintf("step %d", i+1)
+		}
+		step.Method = strings.ToUpper(step.Method)
+		if step.Method == "" {
+			step.Method = http.MethodGet
+			if step.Body != "" {
+				step.Method = http.MethodPost
+			}
+		}
+		if !strings.HasPrefix(step.Path, "/") {
+			step.Path = "/" + step.Path
+		}
+		texts := []string{step.Path, step.Body}
+		for _, h := range step.Headers {
+			h.Header = http.CanonicalHeaderKey(h.Header)
+			texts = append(texts, h.Header, h.Value)
+		}
+		holdsPayload := false
+		for _, text := range texts {
+			for _, m := range ScenarioVariable.FindAllStringSubmatch(text, -1) {
+				if !known[m[1]] {
+					return nil, fmt.Errorf("scenario %v: %v references unknown variable %v", scenario.Name, step.Name, m[1])
+				}
+				if payloadVariable[m[1]] {
+					holdsPayload = true
+				}
+			}
+		}
+		if holdsPayload {
+			payloadSteps = append(payloadSteps, step)
+		}
+		checked = checked || step.Check
+		for _, v := range step.Extract {
+			if v.Variable == "" || payloadVariable[v.Variable] {
+				return nil, fmt.Errorf("scenario %v: %v extracts a value without a valid variable name", scenario.Name, step.Name)
+			}
+			if err := validateExtract(&v.Extract); err != nil {
+				return nil, fmt.Errorf("scenario %v: %v: %v", scenario.Name, step.Name, err)
+			}
+			known[v.Variable] = true
+		}
+	}
+	if len(payloadSteps) == 0 {
+		return nil, fmt.Errorf("scenario %v has no step holding the {{payload}}", scenario.Name)
+	}
+	//without checked steps the block decision is made on the steps holding the payload
+	if !checked {
+		for _, step := range payloadSteps {
+			step.Check = true
+		}
+	}
+	return &scenario, nil
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestLoadScenario(t *testing.T) {
+	tests := []struct {
+		name     string
+		scenario string
+		want     *Scenario
+		err      bool
+	}{
+		{
+			name: "defaults",
+			scenario: `
+steps:
+  - path: cart
+    body: '{}'
+    extract:
+      - variable: cart_id
+        json_path: id
+  - name: add item
+    path: /cart/{{cart_id}}/items
+    headers:
+      - header: content-type
+        value: application/json
+    body: '{"item": "{{ payload_json }}"}'
+`,
+			want: &Scenario{
+				Steps: []*Step{
+					{
+						Name:    "step 1",
+						Method:  "POST",
+						Path:    "/cart",
+						Body:    "{}",
+						Extract: []*Variable{{Variable: "cart_id", Extract: Extract{JSONPath: "id"}}},
+					},
+					{
+						Name:    "add item",
+						Method:  "POST",
+						Path:    "/cart/{{cart_id}}/items",
+						Headers: []*Header{{Header: "Content-Type", Value: "application/json"}},
+						Body:    `{"item": "{{ payload_json }}"}`,
+						Check:   true,
+					},
+				},
+			},
+		},
+		{
+			name: "checkedStep",
+			scenario: `
+name: second order
+steps:
+  - method: put
+    path: /profile?name={{payload_urlencoded}}
+  - path: /profile
+    check: true
+`,
+			want: &Scenario{
+				Name: "second order",
+				Steps: []*Step{
+					{Name: "step 1", Method: "PUT", Path: "/profile?name={{payload_urlencoded}}"},
+					{Name: "step 2", Method: "GET", Path: "/profile", Check: true},
+				},
+			},
+		},
+		{
+			name: "unknownVariable",
+			scenario: `
+steps:
+  - path: /cart/{{cart_id}}?q={{payload}}
+`,
+			err: true,
+		},
+		{
+			name: "variableUsedBeforeExtraction",
+			scenario: `
+steps:
+  - path: /cart/{{cart_id}}?q={{payload}}
+    extract:
+      - variable: cart_id
+        regex: id=(\d+)
+`,
+			err: true,
+		},
+		{
+			name: "noPayload",
+			scenario: `
+steps:
+  - path: /cart
+`,
+			err: true,
+		},
+		{
+			name: "unknownField",
+			scenario: `
+steps:
+  - path: /cart?q={{payload}}
+    checks: true
+`,
+			err: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ioutil.TempFile("", "scenario")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+			f.WriteString(tt.scenario)
+			f.Close()
+			got, err := LoadScenario(f.Name())
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if tt.want.Name == "" {
+				tt.want.Name = got.Name
+			}
+			if ok := cmp.Equal(tt.want, got, cmpopts.IgnoreFields(Extract{}, "Pattern")); !ok {
+				diff := cmp.Diff(tt.want, got, cmpopts.IgnoreFields(Extract{}, "Pattern"))
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+//Parameter is a named value of a request template
+type Parameter struct {
+	Name  string
+	Value string
+}
+
+//RequestTemplate is a request declared in an API description or captured from an application. It is
+//used as the base of test requests in place of the default request, with the payload injected into
+//one of its parameters.
+type RequestTemplate struct {
+	Name   string
+	Method string
+	//Path is appended to the path of the test set URI. {name} placeholders are filled from PathParams.
+	Path       string
+	PathParams []*Parameter
+	Query      []*Parameter
+	Headers    []*Parameter
+	Cookies    []*Parameter
+	//BodyParams are the fields of a form or JSON body, with the values of JSON fields as raw JSON.
+	//Bodies of other content types are sent as Body and can not be injected into.
+	ContentType string
+	BodyParams  []*Parameter
+	Body        string
+}
+
+//templateInjections are the parts of a request template a payload can be injected into,
+//named after the location the payload is checked against
+var templateInjections = []string{"path", "queryarg", "header", "cookie", "body"}
+
+//Params returns the parameters of the template that payloads are injected into for a location
+func (t *RequestTemplate) Params(inject string) []*Parameter {
+	switch inject {
+	case "path":
+		return t.PathParams
+	case "queryarg":
+		return t.Query
+	case "header":
+		return t.Headers
+	case "cookie":
+		return t.Cookies
+	case "body":
+		return t.BodyParams
+	}
+	return nil
+}
+
+//Locations returns a test location for every parameter of the template that include accepts.
+//The locations are reported under the source of the template, the template name and the parameter.
+func (t *RequestTemplate) Locations(source string, include func(inject string, name string) bool) []*TestLocation {
+	var locations []*TestLocation
+	seen := make(map[string]bool)
+	for _, inject := range templateInjections {
+		for _, p := range t.Params(inject) {
+			if seen[inject+p.Name] || !include(inject, p.Name) {
+				continue
+			}
+			seen[inject+p.Name] = true
+			locations = append(locations, &TestLocation{
+				Location: source,
+				Key:      p.Name,
+				Inject:   inject,
+				Request:  t.Name,
+				Template: t,
+			})
+		}
+	}
+	return locations
+}
+
+//uniqueLocations drops locations reported under the same label as an earlier location,
+//such as the same request captured more than once
+func uniqueLocations(locations []*TestLocation) []*TestLocation {
+	var unique []*TestLocation
+	seen := make(map[string]bool)
+	for _, l := range locations {
+		if seen[l.Label()] {
+			continue
+		}
+		seen[l.Label()] = true
+		unique = append(unique, l)
+	}
+	return unique
+}
+
+//sortedKeys returns the keys of the map in order so templates are built deterministically
+func sortedKeys(m map[string]interface{}) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+//JSONBody returns true if the body of the template is JSON
+func (t *RequestTemplate) JSONBody() bool {
+	mediaType, _, _ := mime.ParseMediaType(t.ContentType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+//includeNames accepts the parameters named inject:name, or the name alone to match any part of the
+//request. Without names every parameter except the headers is accepted.
+func includeNames(names []string) func(inject string, name string) bool {
+	return func(inject string, name string) bool {
+		if len(names) == 0 {
+			return inject != "header"
+		}
+		for _, n := range names {
+			if n == name || n == inject+":"+name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+//templateLocations loads the requests captured in the file of a har, curl or postman location and returns a
+//location for every parameter of the requests the payload is injected into
+func templateLocations(location *TestLocation, parameters []string) ([]*TestLocation, error) {
+	if location.File == "" {
+		return nil, fmt.Errorf("location %v requires a file", location.Location)
+	}
+	if location.Environment != "" && location.Location != "postman" {
+		return nil, fmt.Errorf("environment is only supported for the postman location")
+	}
+	//the captured requests are sent as captured, the options shaping the default request are not applied to them
+	if location.Method != "" || location.MethodOverride != "" || len(location.Padding) != 0 || len(location.Probes) != 0 {
+		return nil, fmt.Errorf("method, method_override, padding and probes are not supported for the %v location", location.Location)
+	}
+	var templates []*RequestTemplate
+	var err error
+	switch location.Location {
+	case "har":
+		templates, err = LoadHAR(location.File)
+	case "curl":
+		templates, err = LoadCurl(location.File)
+	case "postman":
+		templates, err = LoadPostman(location.File, location.Environment)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var locations []*TestLocation
+	for _, t := range templates {
+		locations = append(locations, t.Locations(location.Location, includeNames(parameters))...)
+	}
+	if len(locations) == 0 {
+		return nil, fmt.Errorf("no parameters to inject into found in %v", location.File)
+	}
+	return uniqueLocations(locations), nil
+}
+
+//capturedTemplate builds the template of a request captured from an application. Headers set by the
+//client for the connection are dropped, and the cookies and the content type are taken from the headers.
+func capturedTemplate(method string, rawURL string, headers []*Parameter, contentType string, body string) (*RequestTemplate, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	method = strings.ToUpper(method)
+	t := &RequestTemplate{
+		Name:        method + " " + path,
+		Method:      method,
+		Path:        path,
+		Query:       queryParams(u.RawQuery),
+		ContentType: contentType,
+	}
+	for _, h := range headers {
+		switch strings.ToLower(h.Name) {
+		case "host", "content-length", "connection", "keep-alive", "proxy-connection", "transfer-encoding", "te", "upgrade":
+			continue
+		case "cookie":
+			t.Cookies = append(t.Cookies, cookieParams(h.Value)...)
+			continue
+		case "content-type":
+			if t.ContentType == "" {
+				t.ContentType = h.Value
+			}
+			continue
+		}
+		//HTTP/2 pseudo headers recorded by browsers
+		if strings.HasPrefix(h.Name, ":") {
+			continue
+		}
+		t.Headers = append(t.Headers, &Parameter{Name: h.Name, Value: h.Value})
+	}
+	bodyParams(t, body)
+	return t, nil
+}
+
+//bodyParams splits a form or JSON object body into the body parameters of the template, keeping
+//other bodies as they are
+func bodyParams(t *RequestTemplate, body string) {
+	if body == "" {
+		return
+	}
+	mediaType, _, _ := mime.ParseMediaType(t.ContentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		t.BodyParams = queryParams(body)
+		return
+	}
+	if t.JSONBody() {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(body), &fields); err == nil {
+			for _, k := range sortedRawKeys(fields) {
+				var value bytes.Buffer
+				if err := json.Compact(&value, fields[k]); err != nil {
+					value.Write(fields[k])
+				}
+				t.BodyParams = append(t.BodyParams, &Parameter{Name: k, Value: value.String()})
+			}
+			return
+		}
+	}
+	t.Body = body
+}
+
+//queryParams splits a query string or form body into its parameters, in order
+func queryParams(query string) []*Parameter {
+	var params []*Parameter
+	for _, field := range strings.Split(query, "&") {
+		if field == "" {
+			continue
+		}
+		kv := strings.SplitN(field, "=", 2)
+		name, err := url.QueryUnescape(kv[0])
+		if err != nil {
+			name = kv[0]
+		}
+		p := &Parameter{Name: name}
+		if len(kv) == 2 {
+			p.Value, err = url.QueryUnescape(kv[1])
+			if err != nil {
+				p.Value = kv[1]
+			}
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+//cookieParams splits a Cookie header into its cookies
+func cookieParams(header string) []*Parameter {
+	var params []*Parameter
+	for _, cookie := range strings.Split(header, ";") {
+		cookie = strings.TrimSpace(cookie)
+		if cookie == "" {
+			continue
+		}
+		kv := strings.SplitN(cookie, "=", 2)
+		p := &Parameter{Name: kv[0]}
+		if len(kv) == 2 {
+			p.Value = kv[1]
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+//sortedRawKeys returns the keys of the JSON object in order
+func sortedRawKeys(m map[string]json.RawMessage) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
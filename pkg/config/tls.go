@@ -0,0 +1,93 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+//TLS holds the TLS settings of a WAF
+type TLS struct {
+	//CA is a PEM bundle of the certificate authorities trusted in addition to the system ones
+	CA string `yaml:"ca"`
+	//Cert and Key are the PEM client certificate and key presented for mutual TLS
+	Cert       string   `yaml:"cert"`
+	Key        string   `yaml:"key"`
+	ServerName string   `yaml:"server_name"`
+	MinVersion string   `yaml:"min_version"`
+	MaxVersion string   `yaml:"max_version"`
+	Ciphers    []string `yaml:"ciphers"`
+	//InsecureSkipVerify accepts any server certificate, such as the self-signed ones of staging WAFs
+	InsecureSkipVerify bool `yaml:"insecure_skip_verify"`
+}
+
+//tlsVersions are the TLS versions by the names accepted in the configuration
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+//Config builds the client TLS configuration, loading the CA bundle and client certificate
+func (t *TLS) Config() (*tls.Config, error) {
+	cfg := &tls.Config{
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+	if t.CA != "" {
+		pem, err := ioutil.ReadFile(t.CA)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in ca %v", t.CA)
+		}
+		cfg.RootCAs = pool
+	}
+	if (t.Cert == "") != (t.Key == "") {
+		return nil, fmt.Errorf("cert and key must be set together")
+	}
+	if t.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(t.Cert, t.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	for _, v := range []struct {
+		name    string
+		version *uint16
+	}{{t.MinVersion, &cfg.MinVersion}, {t.MaxVersion, &cfg.MaxVersion}} {
+		if v.name == "" {
+			continue
+		}
+		version, ok := tlsVersions[v.name]
+		if !ok {
+			return nil, fmt.Errorf("unknown TLS version %q", v.name)
+		}
+		*v.version = version
+	}
+	if cfg.MinVersion != 0 && cfg.MaxVersion != 0 && cfg.MinVersion > cfg.MaxVersion {
+		return nil, fmt.Errorf("min_version %v is above max_version %v", t.MinVersion, t.MaxVersion)
+	}
+	if len(t.Ciphers) > 0 {
+		suites := make(map[string]uint16)
+		for _, s := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+			suites[s.Name] = s.ID
+		}
+		for _, name := range t.Ciphers {
+			id, ok := suites[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown cipher suite %q", name)
+			}
+			cfg.CipherSuites = append(cfg.CipherSuites, id)
+		}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+//writeCertificate writes a self-signed certificate and its key as PEM files in dir
+func writeCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "waftf test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)
+	return certFile, keyFile
+}
+
+func TestTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cert, key := writeCertificate(t, dir)
+	tests := []struct {
+		name  string
+		tls   *TLS
+		check func(*tls.Config) bool
+		err   bool
+	}{
+		{
+			name: "full",
+			tls: &TLS{
+				CA:                 cert,
+				Cert:               cert,
+				Key:                key,
+				ServerName:         "origin.example.com",
+				MinVersion:         "1.2",
+				MaxVersion:         "1.3",
+				Ciphers:            []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+				InsecureSkipVerify: true,
+			},
+			check: func(cfg *tls.Config) bool {
+				return cfg.RootCAs != nil && len(cfg.Certificates) == 1 && cfg.ServerName == "origin.example.com" &&
+					cfg.MinVersion == tls.VersionTLS12 && cfg.MaxVersion == tls.VersionTLS13 &&
+					len(cfg.CipherSuites) == 1 && cfg.CipherSuites[0] == tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 &&
+					cfg.InsecureSkipVerify
+			},
+		},
+		{
+			name:  "empty",
+			tls:   &TLS{},
+			check: func(cfg *tls.Config) bool { return cfg.RootCAs == nil && cfg.MinVersion == 0 },
+		},
+		{
+			name: "certWithoutKey",
+			tls:  &TLS{Cert: cert},
+			err:  true,
+		},
+		{
+			name: "caWithoutCertificates",
+			tls:  &TLS{CA: key},
+			err:  true,
+		},
+		{
+			name: "unknownVersion",
+			tls:  &TLS{MinVersion: "1.4"},
+			err:  true,
+		},
+		{
+			name: "inverted",
+			tls:  &TLS{MinVersion: "1.3", MaxVersion: "1.2"},
+			err:  true,
+		},
+		{
+			name: "unknownCipher",
+			tls:  &TLS{Ciphers: []string{"TLS_NULL"}},
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := tt.tls.Config()
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v", err)
+			}
+			if err == nil && !tt.check(cfg) {
+				t.Errorf("unexpected configuration %+v", cfg)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"html/template"
 	"io/ioutil"
-	"log"
 	"math"
 	"os"
 	"path/filepath"
@@ -24,10 +23,18 @@ type TestResult struct {
 	Line     int    `json:"-"`
 	Payload  string `json:"-"`
 	Location string `json:"-"`
+	//TestType and PaddingSize are used to count the result
+	TestType    string `json:"-"`
+	PaddingSize int    `json:"-"`
 
-	Outcome  string
-	Request  string
-	Response string
+	Outcome   string
+	Split     string `json:",omitempty"`
+	Desync    string `json:",omitempty"`
+	CloseCode int    `json:",omitempty"`
+	Message   string `json:",omitempty"`
+	Attempts  int    `json:",omitempty"`
+	Request   string
+	Response  string
 }
 
 //FileResult is the file level result object
@@ -61,6 +68,61 @@ type SetCounts struct {
 	TotalFPTestCount int
 	TotalFNTestCount int
 	TotalCount       int
+	Padding          map[int]*PaddingCount `json:",omitempty"`
+	InspectionLimit  int                   `json:",omitempty"`
+	DesyncCount      int                   `json:",omitempty"`
+	RetriedCount     int                   `json:",omitempty"`
+	EffectiveRate    float64               `json:",omitempty"`
+	ThrottledCount   int                   `json:",omitempty"`
+	UnsentCount      int                   `json:",omitempty"`
+}
+
+//PaddingCount stores how many padded false negative tests were sent at a body padding size
+//and how many of them were still detected
+type PaddingCount struct {
+	TestCount     int
+	DetectedCount int
+}
+
+//AddPaddingResult records the outcome of a false negative test sent with the payload
+//the given number of bytes into the body
+func (s *SetCounts) AddPaddingResult(size int, detected bool) {
+	if s.Padding == nil {
+		s.Padding = make(map[int]*PaddingCount)
+	}
+	if s.Padding[size] == nil {
+		s.Padding[size] = &PaddingCount{}
+	}
+	s.Padding[size].TestCount++
+	if detected {
+		s.Padding[size].DetectedCount++
+	}
+}
+
+//RateResult is the result of the rate limiting test of a WAF
+type RateResult struct {
+	Steps   []*RateStep
+	MaxRate int
+	//Threshold is the rate at which the WAF started blocking, 0 when it never did
+	Threshold int `json:",omitempty"`
+	//BlockedAfter is the number of requests sent at the threshold before the first blocked request,
+	//and BlockLatency the seconds from the start of the burst to the first blocked request
+	BlockedAfter int     `json:",omitempty"`
+	BlockLatency float64 `json:",omitempty"`
+	//BlockDuration is the seconds from the first blocked request until the WAF allowed requests again,
+	//or until the test stopped waiting when Recovered is false
+	BlockDuration float64 `json:",omitempty"`
+	Recovered     bool    `json:",omitempty"`
+}
+
+//RateStep is the result of the burst of a rate test sent at a single rate
+type RateStep struct {
+	Rate    int
+	Sent    int
+	Blocked int
+	Errors  int
+	//Latency is the average response time of the burst in milliseconds
+	Latency float64
 }
 
 //Results is the top level result object
@@ -70,7 +132,10 @@ type Results struct {
 	Config      *config.TestRun
 	SetCounts   map[string]*SetCounts
 	FileResults map[string]*FileResult
-	Reporting   *Reporting
+	RateResults map[string]*RateResult `json:",omitempty"`
+	//Incomplete is true when the run was interrupted before all tests were sent
+	Incomplete bool `json:",omitempty"`
+	Reporting  *Reporting
 }
 
 //Reporting is the object defining where reporting templates and outputs are located
@@ -144,7 +209,17 @@ func (r *Results) ProcessResults() {
 		} else {
 			setCount.FnPercent = 0.00
 		}
-		setCount.FailPercent = math.Round((float64(setCount.FnCount)+float64(setCount.FpCount))/float64(setCount.TotalFNTestCount+setCount.TotalFPTestCount)*10000) / 100
+		//no payload tests are sent in the rate test mode
+		if setCount.TotalCount != 0 {
+			setCount.FailPercent = math.Round((float64(setCount.FnCount)+float64(setCount.FpCount))/float64(setCount.TotalCount)*10000) / 100
+		}
+		//the inspection limit is the largest padding at which payloads were still detected
+		setCount.InspectionLimit = 0
+		for size, count := range setCount.Padding {
+			if count.DetectedCount > 0 && size > setCount.InspectionLimit {
+				setCount.InspectionLimit = size
+			}
+		}
 	}
 	r.EndTime = time.Now().Local().Format("02 Jan 2006, 15:04 MST")
 }
@@ -154,8 +229,19 @@ func (r *Results) ProcessResults() {
 func (r *Results) ReportData() *OverallReport {
 	var locations, testSets []string
 	//get locations
+	seen := make(map[string]bool)
 	for _, loc := range r.Config.Locations {
-		locations = append(locations, loc.Location)
+		locations = append(locations, loc.Label())
+		seen[loc.Label()] = true
+	}
+	//add the locations only tested against some of the test sets
+	for _, testSet := range r.Config.TestSets {
+		for _, loc := range testSet.Locations {
+			if !seen[loc.Label()] {
+				locations = append(locations, loc.Label())
+				seen[loc.Label()] = true
+			}
+		}
 	}
 	//get testSets
 	for _, testSet := range r.Config.TestSets {
@@ -240,8 +326,7 @@ func (r *Results) GenerateReports() error {
 	jsonOut := filepath.FromSlash(r.Reporting.OutputDir + "/results.json")
 	err := ioutil.WriteFile(jsonOut, resultout, 0644)
 	if err != nil {
-		fmt.Printf("unable to write results to json: %v\n", err)
-		log.Fatalf("unable to write results to json: %v", err)
+		return fmt.Errorf("unable to write results to json: %v", err)
 	}
 	templateData := struct {
 		Report *OverallReport
@@ -265,6 +350,7 @@ func (r *Results) GenerateReports() error {
 		"mul": func(per float64) float64 {
 			return math.Max(0.5, math.Min(per/5*100, 100.00))
 		},
+		"size": config.FormatSize,
 	}
 	//get the template for the results
 	summary := string(static.Get("/summary.tmpl"))
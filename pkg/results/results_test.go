@@ -493,6 +493,30 @@ func TestProcessResults(t *testing.T) {
 	}
 }
 
+func TestInspectionLimit(t *testing.T) {
+	r := &Results{
+		SetCounts: map[string]*SetCounts{
+			"Test1": {TotalFNTestCount: 6},
+		},
+	}
+	counts := r.SetCounts["Test1"]
+	counts.AddPaddingResult(8192, true)
+	counts.AddPaddingResult(8192, true)
+	counts.AddPaddingResult(65536, true)
+	counts.AddPaddingResult(65536, false)
+	counts.AddPaddingResult(1048576, false)
+	counts.AddPaddingResult(1048576, false)
+	r.ProcessResults()
+	if counts.InspectionLimit != 65536 {
+		t.Errorf("want: %v\n got: %v", 65536, counts.InspectionLimit)
+	}
+	want := &PaddingCount{TestCount: 2, DetectedCount: 1}
+	if ok := cmp.Equal(counts.Padding[65536], want); !ok {
+		diff := cmp.Diff(want, counts.Padding[65536])
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestReportData(t *testing.T) {
 
 	tests := []struct {